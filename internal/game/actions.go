@@ -16,8 +16,18 @@ const (
 	Assassinate // Assassin: Pay 3 coins to eliminate a card (can be blocked by Contessa)
 	Exchange    // Ambassador: Draw 2 cards, keep 2, return 2 to deck
 	Steal       // Captain: Take 2 coins from another player (can be blocked by Captain/Ambassador)
+
+	// Reformation actions
+	Convert  // Pay 1 coin to change your faction, or 2 to change another player's
+	Embezzle // Claim not to hold a Duke: take every coin in the Treasury Reserve
+
+	// Inquisitor actions
+	Examine // Inquisitor: Look at another player's card and optionally force them to swap it
 )
 
+// ConvertOtherCost is the cost of converting another player rather than yourself
+const ConvertOtherCost = 2
+
 // String returns the string representation of an action
 func (a ActionType) String() string {
 	switch a {
@@ -35,6 +45,12 @@ func (a ActionType) String() string {
 		return "Exchange"
 	case Steal:
 		return "Steal"
+	case Convert:
+		return "Convert"
+	case Embezzle:
+		return "Embezzle"
+	case Examine:
+		return "Examine"
 	default:
 		return "Unknown"
 	}
@@ -42,7 +58,17 @@ func (a ActionType) String() string {
 
 // IsCharacterAction returns true if the action requires a specific character card
 func (a ActionType) IsCharacterAction() bool {
-	return a == Tax || a == Assassinate || a == Exchange || a == Steal
+	return a == Tax || a == Assassinate || a == Exchange || a == Steal || a == Examine
+}
+
+// IsReformationAction returns true if the action only exists in the Reformation variant
+func (a ActionType) IsReformationAction() bool {
+	return a == Convert || a == Embezzle
+}
+
+// IsChallengeable returns true if the action rests on a claim other players may challenge
+func (a ActionType) IsChallengeable() bool {
+	return a.IsCharacterAction() || a == Embezzle
 }
 
 // CanBeBlocked returns true if the action can be blocked by another player
@@ -50,20 +76,25 @@ func (a ActionType) CanBeBlocked() bool {
 	return a == ForeignAid || a == Assassinate || a == Steal
 }
 
-// RequiredCard returns the card needed to perform this character action
+// RequiresTarget returns true if the action must be aimed at another player
+func (a ActionType) RequiresTarget() bool {
+	return a == Coup || a == Assassinate || a == Steal || a == Examine
+}
+
+// AcceptsTarget returns true if the action may be aimed at another player
+func (a ActionType) AcceptsTarget() bool {
+	return a.RequiresTarget() || a == Convert
+}
+
+// RequiredCard returns the first registered character, in card order, that performs this
+// action; games choose among the characters in their own deck with RuleSet.RequiredCard
 func (a ActionType) RequiredCard() Card {
-	switch a {
-	case Tax:
-		return Duke
-	case Assassinate:
-		return Assassin
-	case Exchange:
-		return Ambassador
-	case Steal:
-		return Captain
-	default:
-		return -1 // Invalid card for non-character actions
+	for _, card := range RegisteredCharacters() {
+		if card.CanPerformAction(a) {
+			return card
+		}
 	}
+	return -1 // Invalid card for non-character actions
 }
 
 // GetCost returns the coin cost of the action
@@ -73,6 +104,8 @@ func (a ActionType) GetCost() int {
 		return 7
 	case Assassinate:
 		return 3
+	case Convert:
+		return 1
 	default:
 		return 0
 	}
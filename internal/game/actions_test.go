@@ -17,6 +17,9 @@ func TestActionType_String(t *testing.T) {
 		{Assassinate, "Assassinate"},
 		{Exchange, "Exchange"},
 		{Steal, "Steal"},
+		{Convert, "Convert"},
+		{Embezzle, "Embezzle"},
+		{Examine, "Examine"},
 		{ActionType(99), "Unknown"},
 	}
 
@@ -139,3 +142,26 @@ func TestActionType_GetReward(t *testing.T) {
 		}
 	}
 }
+
+// TDD: Test targeted actions
+func TestActionType_RequiresTarget(t *testing.T) {
+	tests := []struct {
+		action   ActionType
+		expected bool
+	}{
+		{Income, false},
+		{Coup, true},
+		{ForeignAid, false},
+		{Tax, false},
+		{Assassinate, true},
+		{Exchange, false},
+		{Steal, true},
+	}
+
+	for _, test := range tests {
+		if result := test.action.RequiresTarget(); result != test.expected {
+			t.Errorf("ActionType %v.RequiresTarget() = %v, want %v",
+				test.action, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package game
+
+import "fmt"
+
+// Block claims a character card to stop the pending action
+func (g *Game) Block(blockerID string, card Card) error {
+	if err := g.requirePhase("block", PhaseBlock); err != nil {
+		return err
+	}
+
+	pending, blocker, err := g.validateResponse(blockerID)
+	if err != nil {
+		return err
+	}
+
+	if !card.CanBlock(pending.Action) || !g.Rules.HasCharacter(card) {
+		return fmt.Errorf("%s cannot block %s", card, pending.Action)
+	}
+
+	g.emit(Event{Type: EventBlockDeclared, PlayerID: blockerID, Card: card})
+
+	pending.BlockerID = blocker.ID
+	pending.BlockCard = card
+	pending.Passed = make(map[string]bool)
+
+	if g.Rules.UnchallengeableBlocks {
+		// The block stands and the action fails; coins paid for it stay spent
+		return g.finishTurn()
+	}
+
+	return g.transition(PhaseBlockChallenge)
+}
+
+// PassBlock lets an eligible player decline to block the pending action
+func (g *Game) PassBlock(playerID string) error {
+	if err := g.requirePhase("pass a block", PhaseBlock); err != nil {
+		return err
+	}
+
+	pending, player, err := g.validateResponse(playerID)
+	if err != nil {
+		return err
+	}
+
+	g.emit(Event{Type: EventPassed, PlayerID: playerID})
+
+	pending.Passed[player.ID] = true
+	if g.allPassed(pending) {
+		return g.resolvePendingAction()
+	}
+
+	return nil
+}
+
+// openBlockPhase moves a pending action that survived its challenges to the block phase,
+// or resolves it straight away when nobody is able to block it
+func (g *Game) openBlockPhase() error {
+	pending := g.PendingAction
+	if !pending.Action.CanBeBlocked() {
+		return g.resolvePendingAction()
+	}
+
+	if err := g.transition(PhaseBlock); err != nil {
+		return err
+	}
+	pending.Passed = make(map[string]bool)
+
+	if g.allPassed(pending) {
+		// The only eligible blocker was eliminated during the challenge
+		return g.resolvePendingAction()
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package game
+
+import (
+	"testing"
+)
+
+// TDD: Test any player can block Foreign Aid with a Duke
+func TestGame_Block_ForeignAid(t *testing.T) {
+	game := newStartedGame(t, 3)
+
+	if err := game.PerformAction("p0", ForeignAid, ""); err != nil {
+		t.Fatalf("PerformAction(ForeignAid) error = %v, want nil", err)
+	}
+
+	if game.PendingAction == nil || game.Phase != PhaseBlock {
+		t.Fatal("Foreign Aid should open the block phase")
+	}
+
+	if err := game.Block("p2", Contessa); err == nil {
+		t.Error("Block() with Contessa should not stop Foreign Aid")
+	}
+
+	if err := game.Block("p2", Duke); err != nil {
+		t.Fatalf("Block() error = %v, want nil", err)
+	}
+
+	if game.Phase != PhaseBlockChallenge {
+		t.Errorf("Phase = %v, want BlockChallenge", game.Phase)
+	}
+
+	if err := game.PassChallenge("p1"); err == nil {
+		t.Error("PassChallenge() should only accept the actor during the block challenge phase")
+	}
+
+	if err := game.PassChallenge("p0"); err != nil {
+		t.Fatalf("PassChallenge() error = %v, want nil", err)
+	}
+
+	if coins := game.Players["p0"].Coins; coins != 2 {
+		t.Errorf("Coins after blocked Foreign Aid = %v, want 2", coins)
+	}
+
+	if current := game.GetCurrentPlayer(); current.ID != "p1" {
+		t.Errorf("Current player = %v, want p1", current.ID)
+	}
+}
+
+// TDD: Test only the target can block Steal and Assassinate
+func TestGame_Block_OnlyTarget(t *testing.T) {
+	game := newStartedGame(t, 3)
+
+	game.PerformAction("p0", Steal, "p1")
+	game.PassChallenge("p1")
+	game.PassChallenge("p2")
+
+	if err := game.Block("p2", Captain); err == nil {
+		t.Error("Block() should return error for a player who is not the target")
+	}
+
+	if err := game.Block("p1", Ambassador); err != nil {
+		t.Errorf("Block() by target error = %v, want nil", err)
+	}
+}
+
+// TDD: Test blocked assassination keeps the coins spent
+func TestGame_Block_AssassinationCoinsStaySpent(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p0"].Coins = 3
+
+	game.PerformAction("p0", Assassinate, "p1")
+	game.PassChallenge("p1")
+	game.PassChallenge("p2")
+
+	if err := game.Block("p1", Contessa); err != nil {
+		t.Fatalf("Block() error = %v, want nil", err)
+	}
+	game.PassChallenge("p0")
+
+	if coins := game.Players["p0"].Coins; coins != 0 {
+		t.Errorf("Coins after blocked assassination = %v, want 0", coins)
+	}
+
+	if cards := len(game.Players["p1"].Cards); cards != 2 {
+		t.Errorf("Target cards = %v, want 2", cards)
+	}
+}
+
+// TDD: Test challenging a bluffed block lets the action through
+func TestGame_ChallengeBlock_Success(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p1"].Cards = []Card{Duke, Assassin}
+	game.Players["p1"].Coins = 2
+
+	game.PerformAction("p0", Steal, "p1")
+	game.PassChallenge("p1")
+	game.PassChallenge("p2")
+	game.Block("p1", Captain)
+
+	if _, err := game.Challenge("p2"); err == nil {
+		t.Error("Challenge() of a block should only be allowed for the actor")
+	}
+
+	outcome, err := game.Challenge("p0")
+	if err != nil {
+		t.Fatalf("Challenge() error = %v, want nil", err)
+	}
+	chooseLoss(t, game, "p1")
+
+	if !outcome.Successful || outcome.ClaimantID != "p1" {
+		t.Errorf("Challenge() outcome = %+v, want successful against p1", outcome)
+	}
+
+	if cards := len(game.Players["p1"].Cards); cards != 1 {
+		t.Errorf("Blocker cards = %v, want 1", cards)
+	}
+
+	if coins := game.Players["p0"].Coins; coins != 4 {
+		t.Errorf("Actor coins = %v, want 4 (steal resolved)", coins)
+	}
+}
+
+// TDD: Test challenging a truthful block cancels the action
+func TestGame_ChallengeBlock_Failed(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p1"].Cards = []Card{Duke, Assassin}
+
+	game.PerformAction("p0", ForeignAid, "")
+	game.Block("p1", Duke)
+
+	outcome, err := game.Challenge("p0")
+	if err != nil {
+		t.Fatalf("Challenge() error = %v, want nil", err)
+	}
+	chooseLoss(t, game, "p0")
+
+	if outcome.Successful {
+		t.Error("Challenge() against a true block should fail")
+	}
+
+	if cards := len(game.Players["p0"].Cards); cards != 1 {
+		t.Errorf("Actor cards = %v, want 1", cards)
+	}
+
+	if coins := game.Players["p0"].Coins; coins != 2 {
+		t.Errorf("Actor coins = %v, want 2 (action blocked)", coins)
+	}
+
+	if game.PendingAction != nil {
+		t.Error("PendingAction should be cleared")
+	}
+}
+
+// TDD: Test unblockable actions never open a block window
+func TestGame_Block_Unblockable(t *testing.T) {
+	game := newStartedGame(t, 3)
+
+	game.PerformAction("p0", Tax, "")
+	game.PassChallenge("p1")
+	game.PassChallenge("p2")
+
+	if game.PendingAction != nil {
+		t.Error("Tax should resolve without a block window")
+	}
+
+	if err := game.Block("p1", Duke); err == nil {
+		t.Error("Block() should return error without a pending action")
+	}
+}
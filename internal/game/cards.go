@@ -1,6 +1,7 @@
 package game
 
-// Card represents a Coup character card with its unique abilities
+// Card represents a Coup character card; its abilities are defined by the character
+// registered for it
 type Card int
 
 const (
@@ -14,34 +15,68 @@ const (
 	Captain
 	// Contessa blocks Assassination
 	Contessa
+	// Inquisitor replaces the Ambassador in its variant: allows Exchange (draw 1 card) and
+	// Examine (look at another player's card and optionally force a swap), and blocks Steal
+	Inquisitor
 )
 
+// standardCharacters are the five characters of the base game
+var standardCharacters = []Card{Duke, Assassin, Ambassador, Captain, Contessa}
+
 // String returns the string representation of a card
 func (c Card) String() string {
-	switch c {
-	case Duke:
-		return "Duke"
-	case Assassin:
-		return "Assassin"
-	case Ambassador:
-		return "Ambassador"
-	case Captain:
-		return "Captain"
-	case Contessa:
-		return "Contessa"
-	default:
-		return "Unknown"
+	if character, exists := characters.get(c); exists {
+		return character.Name
+	}
+	return "Unknown"
+}
+
+// cardNames returns the string representation of each card
+func cardNames(cards []Card) []string {
+	names := make([]string, len(cards))
+	for i, card := range cards {
+		names[i] = card.String()
 	}
+	return names
+}
+
+// cloneCards returns a copy of a card slice that shares no memory with the original
+func cloneCards(cards []Card) []Card {
+	if cards == nil {
+		return nil
+	}
+	return append(make([]Card, 0, len(cards)), cards...)
 }
 
 // GetAllCards returns all available cards in the deck (3 of each type)
 func GetAllCards() []Card {
+	return buildDeck(3, standardCharacters)
+}
+
+// GetDeckForPlayers returns the deck for a table of the given size
+func GetDeckForPlayers(playerCount int) []Card {
+	return buildDeck(CopiesPerCharacter(playerCount), standardCharacters)
+}
+
+// CopiesPerCharacter returns how many copies of each character the deck holds:
+// 3 for 3-6 players, 4 for 7-8 players and 5 for 9-10 players
+func CopiesPerCharacter(playerCount int) int {
+	switch {
+	case playerCount >= 7 && playerCount <= 8:
+		return 4
+	case playerCount >= 9 && playerCount <= 10:
+		return 5
+	default:
+		return 3
+	}
+}
+
+// buildDeck returns a deck with the given number of copies of each character
+func buildDeck(copies int, characters []Card) []Card {
 	var deck []Card
-	cards := []Card{Duke, Assassin, Ambassador, Captain, Contessa}
 
-	// Add 3 of each card type to the deck
-	for _, card := range cards {
-		for i := 0; i < 3; i++ {
+	for _, card := range characters {
+		for i := 0; i < copies; i++ {
 			deck = append(deck, card)
 		}
 	}
@@ -51,30 +86,17 @@ func GetAllCards() []Card {
 
 // CanPerformAction checks if a card can perform a specific action
 func (c Card) CanPerformAction(action ActionType) bool {
-	switch action {
-	case Tax:
-		return c == Duke
-	case Assassinate:
-		return c == Assassin
-	case Exchange:
-		return c == Ambassador
-	case Steal:
-		return c == Captain
-	default:
+	character, exists := characters.get(c)
+	if !exists {
 		return false
 	}
+
+	_, enabled := character.Ability(action)
+	return enabled
 }
 
 // CanBlock checks if a card can block a specific action
 func (c Card) CanBlock(action ActionType) bool {
-	switch action {
-	case ForeignAid:
-		return c == Duke
-	case Assassinate:
-		return c == Contessa
-	case Steal:
-		return c == Ambassador || c == Captain
-	default:
-		return false
-	}
+	character, exists := characters.get(c)
+	return exists && character.CanBlock(action)
 }
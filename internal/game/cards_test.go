@@ -15,6 +15,7 @@ func TestCard_String(t *testing.T) {
 		{Ambassador, "Ambassador"},
 		{Captain, "Captain"},
 		{Contessa, "Contessa"},
+		{Inquisitor, "Inquisitor"},
 		{Card(99), "Unknown"},
 	}
 
@@ -62,6 +63,9 @@ func TestCard_CanPerformAction(t *testing.T) {
 		{Ambassador, Exchange, true},
 		{Captain, Steal, true},
 		{Contessa, Tax, false},
+		{Inquisitor, Exchange, true},
+		{Inquisitor, Examine, true},
+		{Ambassador, Examine, false},
 	}
 
 	for _, test := range tests {
@@ -84,6 +88,7 @@ func TestCard_CanBlock(t *testing.T) {
 		{Contessa, Assassinate, true},
 		{Ambassador, Steal, true},
 		{Captain, Steal, true},
+		{Inquisitor, Steal, true},
 		{Assassin, ForeignAid, false},
 	}
 
@@ -94,3 +99,46 @@ func TestCard_CanBlock(t *testing.T) {
 		}
 	}
 }
+
+// TDD: Test copies per character scale with the table size
+func TestCopiesPerCharacter(t *testing.T) {
+	tests := []struct {
+		players  int
+		expected int
+	}{
+		{2, 3},
+		{3, 3},
+		{6, 3},
+		{7, 4},
+		{8, 4},
+		{9, 5},
+		{10, 5},
+		{11, 3},
+	}
+
+	for _, test := range tests {
+		if result := CopiesPerCharacter(test.players); result != test.expected {
+			t.Errorf("CopiesPerCharacter(%d) = %v, want %v", test.players, result, test.expected)
+		}
+	}
+}
+
+// TDD: Test deck composition for a table
+func TestGetDeckForPlayers(t *testing.T) {
+	deck := GetDeckForPlayers(9)
+
+	if len(deck) != 25 {
+		t.Errorf("GetDeckForPlayers(9) length = %v, want 25", len(deck))
+	}
+
+	counts := make(map[Card]int)
+	for _, card := range deck {
+		counts[card]++
+	}
+
+	for _, card := range []Card{Duke, Assassin, Ambassador, Captain, Contessa} {
+		if counts[card] != 5 {
+			t.Errorf("GetDeckForPlayers(9) has %d %v, want 5", counts[card], card)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package game
+
+import "fmt"
+
+// PendingAction is a declared action waiting for the other players to challenge, block or pass
+type PendingAction struct {
+	ActorID   string          `json:"actor_id"`
+	Action    ActionType      `json:"action"`
+	Claim     Card            `json:"claim"`
+	TargetID  string          `json:"target_id,omitempty"`
+	BlockerID string          `json:"blocker_id,omitempty"`
+	BlockCard Card            `json:"block_card"`
+	Passed    map[string]bool `json:"-"`
+}
+
+// GetPublicInfo returns the pending action information visible to all players
+func (pa *PendingAction) GetPublicInfo() map[string]interface{} {
+	info := map[string]interface{}{
+		"actor_id":  pa.ActorID,
+		"action":    pa.Action.String(),
+		"target_id": pa.TargetID,
+	}
+
+	if pa.Action.IsCharacterAction() {
+		info["claim"] = pa.Claim.String()
+	}
+
+	if pa.Action == Embezzle {
+		info["denies"] = Duke.String()
+	}
+
+	if pa.BlockerID != "" {
+		info["blocker_id"] = pa.BlockerID
+		info["block_card"] = pa.BlockCard.String()
+	}
+
+	return info
+}
+
+// ChallengeOutcome describes how a challenge was resolved
+type ChallengeOutcome struct {
+	ChallengerID string `json:"challenger_id"`
+	ClaimantID   string `json:"claimant_id"`
+	ClaimantName string `json:"claimant_name"`
+	Card         Card   `json:"card"`
+	Denied       bool   `json:"denied,omitempty"` // the claimant claimed not to hold the card
+	Successful   bool   `json:"successful"`
+}
+
+// MessageID returns the translation key describing the outcome
+func (co *ChallengeOutcome) MessageID() string {
+	if co.Denied {
+		if co.Successful {
+			return "denial_challenge_success"
+		}
+		return "denial_challenge_failed"
+	}
+
+	if co.Successful {
+		return "challenge_success"
+	}
+	return "challenge_failed"
+}
+
+// MessageData returns the template data for the outcome translation
+func (co *ChallengeOutcome) MessageData() map[string]interface{} {
+	return map[string]interface{}{
+		"Player": co.ClaimantName,
+	}
+}
+
+// Challenge disputes the character claim currently on the table: the actor's claim during
+// the challenge phase, or the blocker's claim during the block challenge phase
+func (g *Game) Challenge(challengerID string) (*ChallengeOutcome, error) {
+	if err := g.requirePhase("challenge", PhaseChallenge, PhaseBlockChallenge); err != nil {
+		return nil, err
+	}
+
+	pending, challenger, err := g.validateResponse(challengerID)
+	if err != nil {
+		return nil, err
+	}
+
+	g.emit(Event{Type: EventChallenged, PlayerID: challengerID})
+
+	if g.Phase == PhaseChallenge {
+		return g.challengeAction(pending, challenger)
+	}
+	return g.challengeBlock(pending, challenger)
+}
+
+// PassChallenge lets a player decline to challenge the claim currently on the table
+func (g *Game) PassChallenge(playerID string) error {
+	if err := g.requirePhase("pass a challenge", PhaseChallenge, PhaseBlockChallenge); err != nil {
+		return err
+	}
+
+	pending, player, err := g.validateResponse(playerID)
+	if err != nil {
+		return err
+	}
+
+	g.emit(Event{Type: EventPassed, PlayerID: playerID})
+
+	if g.Phase == PhaseBlockChallenge {
+		// The actor accepts the block and the action fails; coins paid for it stay spent
+		return g.finishTurn()
+	}
+
+	pending.Passed[player.ID] = true
+	if g.allPassed(pending) {
+		return g.openBlockPhase()
+	}
+
+	return nil
+}
+
+// challengeAction resolves a challenge against the actor's claim
+func (g *Game) challengeAction(pending *PendingAction, challenger *Player) (*ChallengeOutcome, error) {
+	claimant := g.Players[pending.ActorID]
+
+	var outcome *ChallengeOutcome
+	if pending.Action == Embezzle {
+		outcome = g.newChallengeOutcome(challenger, claimant, Duke, true)
+	} else {
+		outcome = g.newChallengeOutcome(challenger, claimant, pending.Claim, false)
+	}
+
+	if outcome.Successful {
+		// The claim was a bluff: the action is cancelled and its cost refunded
+		g.takeFromTreasury(claimant, g.actionCost(pending.Action, pending.TargetID))
+		return outcome, g.requireInfluenceLoss(claimant, afterLossFinish)
+	}
+
+	if outcome.Denied {
+		// Proving the absence of a card shows the whole hand, so all of it is replaced
+		g.replaceHand(claimant)
+	} else if err := g.replaceRevealedCard(claimant, outcome.Card); err != nil {
+		return nil, err
+	}
+
+	if g.inAmnesty() {
+		return outcome, g.continueAfterLoss(afterLossBlock)
+	}
+	return outcome, g.requireInfluenceLoss(challenger, afterLossBlock)
+}
+
+// challengeBlock resolves the actor's challenge against the blocker's claim
+func (g *Game) challengeBlock(pending *PendingAction, challenger *Player) (*ChallengeOutcome, error) {
+	claimant := g.Players[pending.BlockerID]
+	outcome := g.newChallengeOutcome(challenger, claimant, pending.BlockCard, false)
+
+	if outcome.Successful {
+		// The block was a bluff: the blocker is punished and the action goes through
+		return outcome, g.requireInfluenceLoss(claimant, afterLossResolve)
+	}
+
+	if err := g.replaceRevealedCard(claimant, outcome.Card); err != nil {
+		return nil, err
+	}
+
+	if g.inAmnesty() {
+		return outcome, g.continueAfterLoss(afterLossFinish)
+	}
+	return outcome, g.requireInfluenceLoss(challenger, afterLossFinish)
+}
+
+// newChallengeOutcome checks whether the claimant holds the claimed card, or for a denied
+// claim whether they hold the card they said they did not have
+func (g *Game) newChallengeOutcome(challenger, claimant *Player, claim Card, denied bool) *ChallengeOutcome {
+	outcome := &ChallengeOutcome{
+		ChallengerID: challenger.ID,
+		ClaimantID:   claimant.ID,
+		ClaimantName: claimant.Name,
+		Card:         claim,
+		Denied:       denied,
+		Successful:   claimant.HasCard(claim) == denied,
+	}
+
+	g.emit(Event{
+		Type:       EventChallengeResolved,
+		PlayerID:   claimant.ID,
+		TargetID:   challenger.ID,
+		Card:       claim,
+		Successful: outcome.Successful,
+	})
+
+	return outcome
+}
+
+// validateResponse checks that a player may respond to the pending action in the current phase
+func (g *Game) validateResponse(playerID string) (*PendingAction, *Player, error) {
+	pending := g.PendingAction
+
+	player, exists := g.Players[playerID]
+	if !exists {
+		return nil, nil, fmt.Errorf("player with ID %s not found", playerID)
+	}
+
+	if !player.IsAlive {
+		return nil, nil, fmt.Errorf("player %s has been eliminated", playerID)
+	}
+
+	if pending.Passed[playerID] {
+		return nil, nil, fmt.Errorf("player %s has already passed", playerID)
+	}
+
+	if !g.canRespond(pending, playerID) {
+		return nil, nil, fmt.Errorf("player %s cannot respond during the %s phase", playerID, g.Phase)
+	}
+
+	return pending, player, nil
+}
+
+// canRespond reports whether a player takes part in the current response phase
+func (g *Game) canRespond(pending *PendingAction, playerID string) bool {
+	switch g.Phase {
+	case PhaseChallenge:
+		return playerID != pending.ActorID
+	case PhaseBlock:
+		if pending.Action.RequiresTarget() {
+			return playerID == pending.TargetID
+		}
+		if g.sameFactionProtected(g.Players[playerID], g.Players[pending.ActorID]) {
+			// Players cannot block the Foreign Aid of their own faction
+			return false
+		}
+		return playerID != pending.ActorID
+	case PhaseBlockChallenge:
+		return playerID == pending.ActorID
+	default:
+		return false
+	}
+}
+
+// allPassed reports whether every living player in the current phase has passed
+func (g *Game) allPassed(pending *PendingAction) bool {
+	return len(g.respondingPlayers(pending)) == 0
+}
+
+// resolvePendingAction applies the pending action and advances the turn
+func (g *Game) resolvePendingAction() error {
+	pending := g.PendingAction
+
+	actor := g.Players[pending.ActorID]
+	var target *Player
+	if pending.TargetID != "" {
+		target = g.Players[pending.TargetID]
+	}
+
+	if !actor.IsAlive || (target != nil && !target.IsAlive) {
+		return g.finishTurn()
+	}
+
+	return g.resolveAction(actor, pending.Action, target)
+}
+
+// replaceHand shuffles a player's revealed hand back into the deck and deals a new one
+func (g *Game) replaceHand(player *Player) {
+	for _, card := range player.Cards {
+		g.emit(Event{Type: EventCardRevealed, PlayerID: player.ID, Card: card})
+	}
+
+	g.Deck = append(g.Deck, player.Cards...)
+	g.rng.ShuffleCards(g.Deck)
+
+	count := len(player.Cards)
+	player.Cards = append(make([]Card, 0, count), g.Deck[:count]...)
+	g.Deck = g.Deck[count:]
+
+	g.emit(Event{Type: EventCardsDrawn, PlayerID: player.ID, Cards: cloneCards(player.Cards)})
+}
+
+// replaceRevealedCard shuffles a revealed card back into the deck and draws a replacement
+func (g *Game) replaceRevealedCard(player *Player, card Card) error {
+	for i, c := range player.Cards {
+		if c == card {
+			g.emit(Event{Type: EventCardRevealed, PlayerID: player.ID, Card: card})
+
+			g.Deck = append(g.Deck, card)
+			g.rng.ShuffleCards(g.Deck)
+
+			player.Cards[i] = g.Deck[0]
+			g.Deck = g.Deck[1:]
+
+			g.emit(Event{Type: EventCardsDrawn, PlayerID: player.ID, Cards: []Card{player.Cards[i]}})
+			return nil
+		}
+	}
+	return fmt.Errorf("player does not have card: %s", card.String())
+}
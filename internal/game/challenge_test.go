@@ -0,0 +1,153 @@
+package game
+
+import (
+	"testing"
+)
+
+// TDD: Test character actions wait for challenges before resolving
+func TestGame_PerformAction_CharacterActionPending(t *testing.T) {
+	game := newStartedGame(t, 3)
+
+	if err := game.PerformAction("p0", Tax, ""); err != nil {
+		t.Fatalf("PerformAction(Tax) error = %v, want nil", err)
+	}
+
+	if game.PendingAction == nil {
+		t.Fatal("PendingAction should be set after a character action")
+	}
+
+	if coins := game.Players["p0"].Coins; coins != 2 {
+		t.Errorf("Coins before resolution = %v, want 2", coins)
+	}
+
+	if err := game.PerformAction("p0", Income, ""); err == nil {
+		t.Error("PerformAction() should return error while an action is pending")
+	}
+
+	if err := game.PassChallenge("p1"); err != nil {
+		t.Errorf("PassChallenge() error = %v, want nil", err)
+	}
+
+	if game.PendingAction == nil {
+		t.Error("PendingAction should remain until every player has passed")
+	}
+
+	if err := game.PassChallenge("p2"); err != nil {
+		t.Errorf("PassChallenge() error = %v, want nil", err)
+	}
+
+	if game.PendingAction != nil {
+		t.Error("PendingAction should be cleared once every player has passed")
+	}
+
+	if coins := game.Players["p0"].Coins; coins != 5 {
+		t.Errorf("Coins after resolution = %v, want 5", coins)
+	}
+}
+
+// TDD: Test invalid challenge responses
+func TestGame_Challenge_Validation(t *testing.T) {
+	game := newStartedGame(t, 3)
+
+	if _, err := game.Challenge("p1"); err == nil {
+		t.Error("Challenge() should return error without a pending action")
+	}
+
+	game.PerformAction("p0", Tax, "")
+
+	if _, err := game.Challenge("p0"); err == nil {
+		t.Error("Challenge() should return error when challenging own action")
+	}
+
+	game.PassChallenge("p1")
+	if _, err := game.Challenge("p1"); err == nil {
+		t.Error("Challenge() should return error after passing")
+	}
+}
+
+// TDD: Test challenging a bluff
+func TestGame_Challenge_Success(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p0"].Cards = []Card{Captain, Contessa}
+	game.Players["p0"].Coins = 3
+
+	game.PerformAction("p0", Assassinate, "p2")
+
+	outcome, err := game.Challenge("p1")
+	if err != nil {
+		t.Fatalf("Challenge() error = %v, want nil", err)
+	}
+	chooseLoss(t, game, "p0")
+
+	if !outcome.Successful {
+		t.Error("Challenge() against a bluff should succeed")
+	}
+
+	if outcome.MessageID() != "challenge_success" {
+		t.Errorf("MessageID() = %v, want challenge_success", outcome.MessageID())
+	}
+
+	if outcome.MessageData()["Player"] != "Player 0" {
+		t.Errorf("MessageData() Player = %v, want Player 0", outcome.MessageData()["Player"])
+	}
+
+	if cards := len(game.Players["p0"].Cards); cards != 1 {
+		t.Errorf("Claimant cards = %v, want 1", cards)
+	}
+
+	if cards := len(game.Players["p2"].Cards); cards != 2 {
+		t.Errorf("Target cards = %v, want 2 (action cancelled)", cards)
+	}
+
+	if coins := game.Players["p0"].Coins; coins != 3 {
+		t.Errorf("Claimant coins = %v, want 3 (cost refunded)", coins)
+	}
+
+	if game.PendingAction != nil {
+		t.Error("PendingAction should be cleared after a successful challenge")
+	}
+
+	if current := game.GetCurrentPlayer(); current.ID != "p1" {
+		t.Errorf("Current player = %v, want p1", current.ID)
+	}
+}
+
+// TDD: Test challenging a truthful claim
+func TestGame_Challenge_Failed(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p0"].Cards = []Card{Duke, Contessa}
+	deckSize := len(game.Deck)
+
+	game.PerformAction("p0", Tax, "")
+
+	outcome, err := game.Challenge("p2")
+	if err != nil {
+		t.Fatalf("Challenge() error = %v, want nil", err)
+	}
+	chooseLoss(t, game, "p2")
+	passAll(t, game)
+
+	if outcome.Successful {
+		t.Error("Challenge() against a true claim should fail")
+	}
+
+	if outcome.MessageID() != "challenge_failed" {
+		t.Errorf("MessageID() = %v, want challenge_failed", outcome.MessageID())
+	}
+
+	if cards := len(game.Players["p0"].Cards); cards != 2 {
+		t.Errorf("Claimant cards = %v, want 2 (card replaced)", cards)
+	}
+
+	if len(game.Deck) != deckSize {
+		t.Errorf("Deck length = %v, want %v", len(game.Deck), deckSize)
+	}
+
+	if cards := len(game.Players["p2"].Cards); cards != 1 {
+		t.Errorf("Challenger cards = %v, want 1", cards)
+	}
+
+	if coins := game.Players["p0"].Coins; coins != 5 {
+		t.Errorf("Claimant coins = %v, want 5 (action resolved)", coins)
+	}
+}
@@ -0,0 +1,168 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// DeckCharacters is the number of different characters a deck is built from
+const DeckCharacters = 5
+
+// Ability is a character action together with what it costs and pays
+type Ability struct {
+	Action ActionType `json:"action"`
+	Cost   int        `json:"cost"`
+	Reward int        `json:"reward"`
+}
+
+// Character defines what holding a card lets a player claim
+type Character struct {
+	Card    Card         `json:"card"`
+	Name    string       `json:"name"`
+	NameKey string       `json:"name_key"` // translation key of the character's name
+	Actions []Ability    `json:"actions"`
+	Blocks  []ActionType `json:"blocks"`
+}
+
+// Ability returns the character's ability for an action, if it enables it
+func (c *Character) Ability(action ActionType) (Ability, bool) {
+	for _, ability := range c.Actions {
+		if ability.Action == action {
+			return ability, true
+		}
+	}
+	return Ability{}, false
+}
+
+// CanBlock reports whether the character can block an action
+func (c *Character) CanBlock(action ActionType) bool {
+	for _, blocked := range c.Blocks {
+		if blocked == action {
+			return true
+		}
+	}
+	return false
+}
+
+// characterRegistry holds every character a deck can be built from
+type characterRegistry struct {
+	mu         sync.RWMutex
+	characters map[Card]*Character
+}
+
+// characters is the registry of the built-in and any registered community characters
+var characters = newCharacterRegistry(
+	Character{Card: Duke, Name: "Duke", NameKey: "character_duke",
+		Actions: []Ability{{Action: Tax, Reward: 3}},
+		Blocks:  []ActionType{ForeignAid}},
+	Character{Card: Assassin, Name: "Assassin", NameKey: "character_assassin",
+		Actions: []Ability{{Action: Assassinate, Cost: 3}}},
+	Character{Card: Ambassador, Name: "Ambassador", NameKey: "character_ambassador",
+		Actions: []Ability{{Action: Exchange}},
+		Blocks:  []ActionType{Steal}},
+	Character{Card: Captain, Name: "Captain", NameKey: "character_captain",
+		Actions: []Ability{{Action: Steal, Reward: 2}},
+		Blocks:  []ActionType{Steal}},
+	Character{Card: Contessa, Name: "Contessa", NameKey: "character_contessa",
+		Blocks: []ActionType{Assassinate}},
+	Character{Card: Inquisitor, Name: "Inquisitor", NameKey: "character_inquisitor",
+		Actions: []Ability{{Action: Exchange}, {Action: Examine}},
+		Blocks:  []ActionType{Steal}},
+)
+
+// newCharacterRegistry creates a registry holding the given characters
+func newCharacterRegistry(builtin ...Character) *characterRegistry {
+	registry := &characterRegistry{characters: make(map[Card]*Character)}
+	for _, character := range builtin {
+		if err := registry.register(character); err != nil {
+			panic(err)
+		}
+	}
+	return registry
+}
+
+// RegisterCharacter adds a character that decks can be built from. Its card must not be
+// taken, and it may only enable character actions and block blockable actions.
+func RegisterCharacter(character Character) error {
+	return characters.register(character)
+}
+
+// GetCharacter returns the definition of a card's character
+func GetCharacter(card Card) (Character, bool) {
+	character, exists := characters.get(card)
+	if !exists {
+		return Character{}, false
+	}
+
+	definition := *character
+	definition.Actions = append([]Ability(nil), character.Actions...)
+	definition.Blocks = append([]ActionType(nil), character.Blocks...)
+	return definition, true
+}
+
+// RegisteredCharacters lists the cards of every registered character in card order
+func RegisteredCharacters() []Card {
+	characters.mu.RLock()
+	defer characters.mu.RUnlock()
+
+	cards := make([]Card, 0, len(characters.characters))
+	for card := range characters.characters {
+		cards = append(cards, card)
+	}
+	sort.Slice(cards, func(i, j int) bool { return cards[i] < cards[j] })
+	return cards
+}
+
+// register validates and stores a character
+func (cr *characterRegistry) register(character Character) error {
+	if character.Card < 0 {
+		return fmt.Errorf("character card cannot be negative: %d", character.Card)
+	}
+
+	if character.Name == "" || character.NameKey == "" {
+		return fmt.Errorf("character %d needs a name and a name key", character.Card)
+	}
+
+	for _, ability := range character.Actions {
+		if !ability.Action.IsCharacterAction() {
+			return fmt.Errorf("%s cannot enable %s: it is not a character action", character.Name, ability.Action)
+		}
+		if ability.Cost < 0 || ability.Reward < 0 {
+			return fmt.Errorf("%s cannot have a negative cost or reward for %s", character.Name, ability.Action)
+		}
+	}
+
+	for _, action := range character.Blocks {
+		if !action.CanBeBlocked() {
+			return fmt.Errorf("%s cannot block %s: it is not blockable", character.Name, action)
+		}
+	}
+
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+
+	if existing, taken := cr.characters[character.Card]; taken {
+		return fmt.Errorf("card %d is already registered to %s", character.Card, existing.Name)
+	}
+	for _, existing := range cr.characters {
+		if existing.Name == character.Name {
+			return fmt.Errorf("character %s is already registered", character.Name)
+		}
+	}
+
+	definition := character
+	definition.Actions = append([]Ability(nil), character.Actions...)
+	definition.Blocks = append([]ActionType(nil), character.Blocks...)
+	cr.characters[character.Card] = &definition
+	return nil
+}
+
+// get looks up a character without copying it
+func (cr *characterRegistry) get(card Card) (*Character, bool) {
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+
+	character, exists := cr.characters[card]
+	return character, exists
+}
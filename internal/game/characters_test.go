@@ -0,0 +1,137 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Banker is a community character used to test the registry: it collects Tax for 4 coins
+// and blocks Foreign Aid, taking the Duke's place in the deck
+const Banker Card = 100
+
+func init() {
+	if err := RegisterCharacter(Character{
+		Card:    Banker,
+		Name:    "Banker",
+		NameKey: "character_banker",
+		Actions: []Ability{{Action: Tax, Reward: 4}},
+		Blocks:  []ActionType{ForeignAid},
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// TDD: Test the built-in characters are registered
+func TestGetCharacter(t *testing.T) {
+	duke, exists := GetCharacter(Duke)
+	if !exists {
+		t.Fatal("GetCharacter(Duke) should exist")
+	}
+
+	if duke.Name != "Duke" || duke.NameKey != "character_duke" {
+		t.Errorf("GetCharacter(Duke) = %+v", duke)
+	}
+
+	if ability, ok := duke.Ability(Tax); !ok || ability.Reward != 3 {
+		t.Errorf("Duke Tax ability = %+v, want a reward of 3", ability)
+	}
+
+	// Changing the returned definition must not change the registry
+	duke.Blocks[0] = Steal
+	if !Duke.CanBlock(ForeignAid) || Duke.CanBlock(Steal) {
+		t.Error("GetCharacter() should return a copy of the definition")
+	}
+
+	if _, exists := GetCharacter(Card(99)); exists {
+		t.Error("GetCharacter() of an unregistered card should not exist")
+	}
+
+	cards := RegisteredCharacters()
+	if !reflect.DeepEqual(cards[:6], []Card{Duke, Assassin, Ambassador, Captain, Contessa, Inquisitor}) {
+		t.Errorf("RegisteredCharacters() = %v, want the built-ins first", cards)
+	}
+}
+
+// TDD: Test invalid characters are rejected
+func TestRegisterCharacter_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		character Character
+	}{
+		{"taken card", Character{Card: Duke, Name: "Other", NameKey: "character_other"}},
+		{"taken name", Character{Card: 101, Name: "Duke", NameKey: "character_other"}},
+		{"no name", Character{Card: 101}},
+		{"negative card", Character{Card: -2, Name: "Other", NameKey: "character_other"}},
+		{"basic action", Character{Card: 101, Name: "Other", NameKey: "character_other", Actions: []Ability{{Action: Income}}}},
+		{"negative cost", Character{Card: 101, Name: "Other", NameKey: "character_other", Actions: []Ability{{Action: Tax, Cost: -1}}}},
+		{"unblockable", Character{Card: 101, Name: "Other", NameKey: "character_other", Blocks: []ActionType{Coup}}},
+	}
+
+	for _, test := range tests {
+		if err := RegisterCharacter(test.character); err == nil {
+			t.Errorf("RegisterCharacter() with %s should fail", test.name)
+		}
+	}
+}
+
+// TDD: Test a game can be played with a deck of chosen characters
+func TestGame_CustomDeck(t *testing.T) {
+	rules := DefaultRuleSet()
+	rules.Deck = []Card{Banker, Assassin, Ambassador, Captain, Contessa}
+
+	game := newRuledGame(t, rules, 3)
+	game.Players["p0"].Cards = []Card{Banker, Captain}
+
+	game.PerformAction("p0", Tax, "")
+	if claim := game.PendingAction.GetPublicInfo()["claim"]; claim != "Banker" {
+		t.Errorf("Tax claim = %v, want Banker", claim)
+	}
+	passAll(t, game)
+
+	if coins := game.Players["p0"].Coins; coins != 6 {
+		t.Errorf("Coins after Banker Tax = %v, want 6", coins)
+	}
+
+	game.PerformAction("p1", ForeignAid, "")
+	if err := game.Block("p2", Duke); err == nil {
+		t.Error("Block() with a Duke should fail when the Duke is not in the deck")
+	}
+	if err := game.Block("p2", Banker); err != nil {
+		t.Errorf("Block() with a Banker error = %v", err)
+	}
+}
+
+// TDD: Test chosen decks are validated
+func TestRuleSet_Validate_Deck(t *testing.T) {
+	tests := []struct {
+		name string
+		deck []Card
+	}{
+		{"four characters", []Card{Duke, Assassin, Ambassador, Captain}},
+		{"repeated character", []Card{Duke, Duke, Ambassador, Captain, Contessa}},
+		{"unregistered character", []Card{Duke, Assassin, Ambassador, Captain, Card(99)}},
+		{"two characters performing Tax", []Card{Duke, Banker, Ambassador, Captain, Contessa}},
+	}
+
+	for _, test := range tests {
+		rules := DefaultRuleSet()
+		rules.Deck = test.deck
+		if err := rules.Validate(); err == nil {
+			t.Errorf("Validate() with %s should fail", test.name)
+		}
+	}
+
+	rules := DefaultRuleSet()
+	rules.Deck = []Card{Duke, Assassin, Inquisitor, Captain, Contessa}
+	if err := rules.Validate(); err != nil {
+		t.Errorf("Validate() with the Inquisitor deck error = %v", err)
+	}
+	if !rules.ActionEnabled(Examine) || rules.RequiredCard(Exchange) != Inquisitor {
+		t.Error("A deck with the Inquisitor should enable Examine and claim Exchange with it")
+	}
+
+	rules.Inquisitor = true
+	if err := rules.Validate(); err == nil {
+		t.Error("Validate() with both a deck and the Inquisitor variant should fail")
+	}
+}
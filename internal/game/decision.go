@@ -0,0 +1,240 @@
+package game
+
+import "time"
+
+// DecisionKind identifies what kind of decision the game is waiting for
+type DecisionKind int
+
+const (
+	// DecisionAction asks the current player to choose an action
+	DecisionAction DecisionKind = iota
+	// DecisionChallenge asks the other players whether to challenge the actor's claim
+	DecisionChallenge
+	// DecisionBlock asks eligible players whether to block the action
+	DecisionBlock
+	// DecisionBlockChallenge asks the actor whether to challenge the block
+	DecisionBlockChallenge
+	// DecisionLoseInfluence asks a player which card to reveal
+	DecisionLoseInfluence
+	// DecisionExchange asks the exchanging player which cards to keep
+	DecisionExchange
+	// DecisionDraft asks the players of a two-player game which starting cards to keep
+	DecisionDraft
+	// DecisionShowCard asks an examined player which card to show the Inquisitor
+	DecisionShowCard
+	// DecisionExamine asks the Inquisitor whether to force the examined card to be swapped
+	DecisionExamine
+)
+
+// String returns the string representation of a decision kind
+func (dk DecisionKind) String() string {
+	switch dk {
+	case DecisionAction:
+		return "action"
+	case DecisionChallenge:
+		return "challenge"
+	case DecisionBlock:
+		return "block"
+	case DecisionBlockChallenge:
+		return "block_challenge"
+	case DecisionLoseInfluence:
+		return "lose_influence"
+	case DecisionExchange:
+		return "exchange"
+	case DecisionDraft:
+		return "draft"
+	case DecisionShowCard:
+		return "show_card"
+	case DecisionExamine:
+		return "examine"
+	default:
+		return "unknown"
+	}
+}
+
+// Responses a player can give to a decision
+const (
+	ResponseAction    = "action"
+	ResponseChallenge = "challenge"
+	ResponsePass      = "pass"
+	ResponseBlock     = "block"
+	ResponseReveal    = "reveal"
+	ResponseKeep      = "keep"
+	ResponseShow      = "show"
+	ResponseReturn    = "return"
+	ResponseSwap      = "swap"
+)
+
+// PendingDecision describes who the game is waiting on and how they may respond
+type PendingDecision struct {
+	Kind      DecisionKind `json:"kind"`
+	PlayerIDs []string     `json:"player_ids"`
+	Responses []string     `json:"responses"`
+	Deadline  *time.Time   `json:"deadline,omitempty"` // nil when the decision has no time limit
+}
+
+// GetPublicInfo returns the decision information visible to all players
+func (pd *PendingDecision) GetPublicInfo() map[string]interface{} {
+	info := map[string]interface{}{
+		"kind":       pd.Kind.String(),
+		"player_ids": pd.PlayerIDs,
+		"responses":  pd.Responses,
+	}
+
+	if pd.Deadline != nil {
+		info["deadline"] = *pd.Deadline
+	}
+
+	return info
+}
+
+// Includes reports whether the player is one of those who must decide
+func (pd *PendingDecision) Includes(playerID string) bool {
+	for _, id := range pd.PlayerIDs {
+		if id == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// PendingDecision returns the decision the game is currently waiting for, or nil if none
+func (g *Game) PendingDecision() *PendingDecision {
+	if g.State != Playing {
+		return nil
+	}
+
+	decision := g.pendingDecision()
+	if decision != nil {
+		decision.Deadline = g.Deadline()
+	}
+	return decision
+}
+
+// pendingDecision describes the decision of the current phase
+func (g *Game) pendingDecision() *PendingDecision {
+	switch g.Phase {
+	case PhaseAction:
+		return &PendingDecision{
+			Kind:      DecisionAction,
+			PlayerIDs: []string{g.GetCurrentPlayer().ID},
+			Responses: []string{ResponseAction},
+		}
+	case PhaseChallenge:
+		return &PendingDecision{
+			Kind:      DecisionChallenge,
+			PlayerIDs: g.respondingPlayers(g.PendingAction),
+			Responses: []string{ResponseChallenge, ResponsePass},
+		}
+	case PhaseBlock:
+		return &PendingDecision{
+			Kind:      DecisionBlock,
+			PlayerIDs: g.respondingPlayers(g.PendingAction),
+			Responses: []string{ResponseBlock, ResponsePass},
+		}
+	case PhaseBlockChallenge:
+		return &PendingDecision{
+			Kind:      DecisionBlockChallenge,
+			PlayerIDs: g.respondingPlayers(g.PendingAction),
+			Responses: []string{ResponseChallenge, ResponsePass},
+		}
+	case PhaseInfluenceLoss:
+		return &PendingDecision{
+			Kind:      DecisionLoseInfluence,
+			PlayerIDs: []string{g.InfluenceLoss.PlayerID},
+			Responses: []string{ResponseReveal},
+		}
+	case PhaseExchange:
+		return &PendingDecision{
+			Kind:      DecisionExchange,
+			PlayerIDs: []string{g.PendingExchange.PlayerID},
+			Responses: []string{ResponseKeep},
+		}
+	case PhaseDraft:
+		return &PendingDecision{
+			Kind:      DecisionDraft,
+			PlayerIDs: g.PendingDraft.playerIDs(g.PlayerOrder),
+			Responses: []string{ResponseKeep},
+		}
+	case PhaseShowCard:
+		return &PendingDecision{
+			Kind:      DecisionShowCard,
+			PlayerIDs: []string{g.Examination.TargetID},
+			Responses: []string{ResponseShow},
+		}
+	case PhaseExamine:
+		return &PendingDecision{
+			Kind:      DecisionExamine,
+			PlayerIDs: []string{g.Examination.ActorID},
+			Responses: []string{ResponseReturn, ResponseSwap},
+		}
+	default:
+		return nil
+	}
+}
+
+// GetDecisionInfo returns the options a player has for the pending decision, or nil if the
+// game is not waiting on them
+func (g *Game) GetDecisionInfo(playerID string) map[string]interface{} {
+	decision := g.PendingDecision()
+	if decision == nil || !decision.Includes(playerID) {
+		return nil
+	}
+
+	info := decision.GetPublicInfo()
+	info["moves"] = g.LegalActions(playerID)
+	player := g.Players[playerID]
+
+	switch decision.Kind {
+	case DecisionAction:
+		info["actions"] = g.availableActions(player)
+	case DecisionBlock:
+		info["cards"] = cardNames(g.blockingCards(g.PendingAction.Action))
+	case DecisionLoseInfluence, DecisionShowCard:
+		info["cards"] = cardNames(player.Cards)
+	case DecisionExchange:
+		info["cards"] = cardNames(g.PendingExchange.Cards)
+		info["keep"] = g.PendingExchange.Keep
+	case DecisionDraft:
+		info["cards"] = cardNames(g.PendingDraft.Pools[playerID])
+		info["keep"] = g.PendingDraft.Keep
+	case DecisionExamine:
+		info["card"] = g.Examination.Card.String()
+	}
+
+	return info
+}
+
+// respondingPlayers lists the living players who still owe a response in the current window
+func (g *Game) respondingPlayers(pending *PendingAction) []string {
+	ids := make([]string, 0, len(g.PlayerOrder))
+	for _, id := range g.PlayerOrder {
+		if g.Players[id].IsAlive && g.canRespond(pending, id) && !pending.Passed[id] {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// availableActions lists the names of the actions the player can legally declare
+func (g *Game) availableActions(player *Player) []string {
+	names := make([]string, 0)
+	for _, move := range g.legalTurnActions(player) {
+		name := move.Action.String()
+		if len(names) == 0 || names[len(names)-1] != name {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// blockingCards lists the cards in the deck that can block an action
+func (g *Game) blockingCards(action ActionType) []Card {
+	cards := make([]Card, 0)
+	for _, card := range g.Rules.Characters() {
+		if card.CanBlock(action) {
+			cards = append(cards, card)
+		}
+	}
+	return cards
+}
@@ -0,0 +1,115 @@
+package game
+
+import (
+	"testing"
+)
+
+// TDD: Test decision kind string representation
+func TestDecisionKind_String(t *testing.T) {
+	tests := []struct {
+		kind     DecisionKind
+		expected string
+	}{
+		{DecisionAction, "action"},
+		{DecisionChallenge, "challenge"},
+		{DecisionBlock, "block"},
+		{DecisionBlockChallenge, "block_challenge"},
+		{DecisionLoseInfluence, "lose_influence"},
+		{DecisionExchange, "exchange"},
+		{DecisionKind(99), "unknown"},
+	}
+
+	for _, test := range tests {
+		if result := test.kind.String(); result != test.expected {
+			t.Errorf("DecisionKind.String() = %v, want %v", result, test.expected)
+		}
+	}
+}
+
+// TDD: Test pending decisions follow the turn
+func TestGame_PendingDecision(t *testing.T) {
+	game := NewGame("test")
+	if game.PendingDecision() != nil {
+		t.Error("PendingDecision() should be nil before the game starts")
+	}
+
+	game = newStartedGame(t, 3)
+	game.Players["p1"].Cards = []Card{Duke, Contessa}
+
+	decision := game.PendingDecision()
+	if decision.Kind != DecisionAction || !decision.Includes("p0") || len(decision.PlayerIDs) != 1 {
+		t.Errorf("PendingDecision() = %+v, want action for p0", decision)
+	}
+
+	game.PerformAction("p0", Steal, "p1")
+	decision = game.PendingDecision()
+	if decision.Kind != DecisionChallenge || len(decision.PlayerIDs) != 2 || decision.Includes("p0") {
+		t.Errorf("PendingDecision() = %+v, want challenge for p1 and p2", decision)
+	}
+
+	game.PassChallenge("p2")
+	decision = game.PendingDecision()
+	if len(decision.PlayerIDs) != 1 || !decision.Includes("p1") {
+		t.Errorf("PendingDecision() players = %v, want [p1]", decision.PlayerIDs)
+	}
+
+	game.PassChallenge("p1")
+	decision = game.PendingDecision()
+	if decision.Kind != DecisionBlock || len(decision.PlayerIDs) != 1 || !decision.Includes("p1") {
+		t.Errorf("PendingDecision() = %+v, want block for p1", decision)
+	}
+
+	game.Block("p1", Captain)
+	decision = game.PendingDecision()
+	if decision.Kind != DecisionBlockChallenge || !decision.Includes("p0") {
+		t.Errorf("PendingDecision() = %+v, want block challenge for p0", decision)
+	}
+
+	game.Challenge("p0")
+	decision = game.PendingDecision()
+	if decision.Kind != DecisionLoseInfluence || !decision.Includes("p1") {
+		t.Errorf("PendingDecision() = %+v, want lose influence for p1", decision)
+	}
+}
+
+// TDD: Test decision options are only shown to the deciding players
+func TestGame_GetDecisionInfo(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p0"].Coins = 10
+
+	info := game.GetDecisionInfo("p0")
+	if info == nil {
+		t.Fatal("GetDecisionInfo() should return options for the current player")
+	}
+
+	if actions, ok := info["actions"].([]string); !ok || len(actions) != 1 || actions[0] != "Coup" {
+		t.Errorf("GetDecisionInfo() actions = %v, want [Coup]", info["actions"])
+	}
+
+	if game.GetDecisionInfo("p1") != nil {
+		t.Error("GetDecisionInfo() should return nil for players who owe no decision")
+	}
+
+	state := game.GetPlayerGameState("p0")
+	if _, ok := state["your_decision"]; !ok {
+		t.Error("Player game state should contain your_decision")
+	}
+
+	if _, ok := game.GetGameState()["pending_decision"]; !ok {
+		t.Error("Game state should contain pending_decision")
+	}
+}
+
+// TDD: Test block decisions list the blocking cards
+func TestGame_GetDecisionInfo_Block(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.PerformAction("p0", Steal, "p2")
+	game.PassChallenge("p1")
+	game.PassChallenge("p2")
+
+	info := game.GetDecisionInfo("p2")
+	cards, ok := info["cards"].([]string)
+	if !ok || len(cards) != 2 || cards[0] != "Ambassador" || cards[1] != "Captain" {
+		t.Errorf("GetDecisionInfo() cards = %v, want [Ambassador Captain]", info["cards"])
+	}
+}
@@ -0,0 +1,108 @@
+package game
+
+import "fmt"
+
+// TwoPlayerFirstCoins is the number of coins the starting player of a two-player game begins with
+const TwoPlayerFirstCoins = 1
+
+// Draft holds the sets of characters the players of a two-player game pick their hands from
+type Draft struct {
+	Pools map[string][]Card `json:"-"` // players who have not chosen yet, with their sets
+	Keep  int               `json:"keep"`
+}
+
+// Includes reports whether the player still has to pick their starting cards
+func (d *Draft) Includes(playerID string) bool {
+	_, picking := d.Pools[playerID]
+	return picking
+}
+
+// GetPrivateInfo returns the set a player is picking from, visible only to them
+func (d *Draft) GetPrivateInfo(playerID string) map[string]interface{} {
+	return map[string]interface{}{
+		"cards": cardNames(d.Pools[playerID]),
+		"keep":  d.Keep,
+	}
+}
+
+// playerIDs lists the players still picking, in seat order
+func (d *Draft) playerIDs(order []string) []string {
+	ids := make([]string, 0, len(d.Pools))
+	for _, id := range order {
+		if d.Includes(id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// ChooseStartingCards keeps the player's picks from their set and sets the rest aside.
+// The first turn starts once every player has chosen.
+func (g *Game) ChooseStartingCards(playerID string, keep []Card) error {
+	if err := g.requirePhase("choose starting cards", PhaseDraft); err != nil {
+		return err
+	}
+
+	draft := g.PendingDraft
+	if !draft.Includes(playerID) {
+		return fmt.Errorf("player %s is not choosing starting cards", playerID)
+	}
+
+	if len(keep) != draft.Keep {
+		return fmt.Errorf("must keep exactly %d cards, got %d", draft.Keep, len(keep))
+	}
+
+	// The cards not kept leave the game unseen
+	if _, err := removeCards(draft.Pools[playerID], keep); err != nil {
+		return err
+	}
+
+	g.emit(Event{Type: EventDraftChosen, PlayerID: playerID, Cards: cloneCards(keep)})
+
+	g.Players[playerID].Cards = cloneCards(keep)
+	delete(draft.Pools, playerID)
+
+	if len(draft.Pools) > 0 {
+		return g.transition(PhaseDraft)
+	}
+
+	g.PendingDraft = nil
+	if err := g.transition(PhaseAction); err != nil {
+		return err
+	}
+
+	g.emit(Event{Type: EventTurnStarted, PlayerID: g.PlayerOrder[g.CurrentPlayer]})
+	return nil
+}
+
+// startDraft deals every player a full set of characters to pick their hand from; the
+// remaining sets form the deck
+func (g *Game) startDraft() error {
+	characters := g.Rules.Characters()
+	sets := CopiesPerCharacter(len(g.Players))
+
+	draft := &Draft{
+		Pools: make(map[string][]Card, len(g.Players)),
+		Keep:  g.Rules.HandSize,
+	}
+
+	for _, playerID := range g.PlayerOrder {
+		pool := cloneCards(characters)
+		draft.Pools[playerID] = pool
+		g.Players[playerID].handSize = g.Rules.HandSize
+		g.emit(Event{Type: EventCardsDealt, PlayerID: playerID, Cards: cloneCards(pool)})
+	}
+
+	g.Deck = buildDeck(sets-len(g.Players), characters)
+	g.rng.ShuffleCards(g.Deck)
+
+	g.PendingDraft = draft
+	g.CurrentPlayer = 0
+	g.Round = 1
+	g.State = Playing
+
+	now := g.clock.Now()
+	g.StartedAt = &now
+
+	return g.transition(PhaseDraft)
+}
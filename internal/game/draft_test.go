@@ -0,0 +1,140 @@
+package game
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newTwoPlayerGame creates a started head-to-head game waiting for both players to draft
+func newTwoPlayerGame(t *testing.T) *Game {
+	t.Helper()
+	return newRuledGame(t, TwoPlayerRuleSet(), 2)
+}
+
+// TDD: Test a two-player game deals each player a full set to pick from
+func TestGame_TwoPlayer_Start(t *testing.T) {
+	game := newTwoPlayerGame(t)
+
+	if game.Phase != PhaseDraft {
+		t.Fatalf("Phase = %v, want Draft", game.Phase)
+	}
+
+	decision := game.PendingDecision()
+	if decision.Kind != DecisionDraft || !reflect.DeepEqual(decision.PlayerIDs, []string{"p0", "p1"}) {
+		t.Errorf("PendingDecision() = %+v, want both players to draft", decision)
+	}
+
+	draft := game.GetPlayerGameState("p0")["your_draft"].(map[string]interface{})
+	if cards := draft["cards"].([]string); len(cards) != 5 || draft["keep"] != 2 {
+		t.Errorf("Draft = %v, want 5 cards and keep 2", draft)
+	}
+
+	if len(game.Deck) != 5 {
+		t.Errorf("Deck length = %v, want 5 (the third set)", len(game.Deck))
+	}
+
+	if game.Players["p0"].Coins != 1 || game.Players["p1"].Coins != 2 {
+		t.Errorf("Coins = %v and %v, want 1 for the starting player and 2", game.Players["p0"].Coins, game.Players["p1"].Coins)
+	}
+
+	if err := game.CheckCoinInvariant(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TDD: Test the first turn starts once both players have drafted
+func TestGame_ChooseStartingCards(t *testing.T) {
+	game := newTwoPlayerGame(t)
+
+	if err := game.PerformAction("p0", Income, ""); err == nil {
+		t.Error("PerformAction() during the draft should fail")
+	}
+
+	if err := game.ChooseStartingCards("p1", []Card{Duke}); err == nil {
+		t.Error("ChooseStartingCards() with one card should fail")
+	}
+	if err := game.ChooseStartingCards("p1", []Card{Duke, Duke}); err == nil {
+		t.Error("ChooseStartingCards() with a card picked twice should fail")
+	}
+
+	if err := game.ChooseStartingCards("p1", []Card{Duke, Contessa}); err != nil {
+		t.Fatalf("ChooseStartingCards() error = %v", err)
+	}
+	if err := game.ChooseStartingCards("p1", []Card{Captain, Contessa}); err == nil {
+		t.Error("ChooseStartingCards() twice should fail")
+	}
+
+	if game.Phase != PhaseDraft || !reflect.DeepEqual(game.PendingDecision().PlayerIDs, []string{"p0"}) {
+		t.Errorf("Phase = %v, want p0 still drafting", game.Phase)
+	}
+
+	moves := game.LegalActions("p0")
+	if len(moves) != 10 {
+		t.Errorf("LegalActions() during the draft = %d moves, want 10", len(moves))
+	}
+
+	if err := game.ApplyMove(Move{Kind: MoveDraft, PlayerID: "p0", Cards: []Card{Assassin, Captain}}); err != nil {
+		t.Fatalf("ApplyMove(draft) error = %v", err)
+	}
+
+	if game.Phase != PhaseAction || game.GetCurrentPlayer().ID != "p0" || game.PendingDraft != nil {
+		t.Errorf("Phase = %v, want p0's first turn", game.Phase)
+	}
+
+	if !reflect.DeepEqual(game.Players["p0"].Cards, []Card{Assassin, Captain}) {
+		t.Errorf("p0 cards = %v, want [Assassin Captain]", game.Players["p0"].Cards)
+	}
+}
+
+// TDD: Test the two-player rules need exactly two players
+func TestGame_TwoPlayer_PlayerCount(t *testing.T) {
+	game := NewGame("test", WithRuleSet(TwoPlayerRuleSet()))
+	game.AddPlayer(NewPlayer("p0", "Player 0"))
+	game.AddPlayer(NewPlayer("p1", "Player 1"))
+
+	if err := game.AddPlayer(NewPlayer("p2", "Player 2")); err == nil {
+		t.Error("AddPlayer() should refuse a third player under two-player rules")
+	}
+
+	crowded := NewGame("test")
+	for i := 0; i < 3; i++ {
+		crowded.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i)))
+	}
+	if err := crowded.SetRuleSet(TwoPlayerRuleSet()); err == nil {
+		t.Error("SetRuleSet() with two-player rules for 3 seated players should fail")
+	}
+
+	rules := TwoPlayerRuleSet()
+	rules.MinPlayers = 3
+	if err := rules.Validate(); err == nil {
+		t.Error("Validate() with two-player rules for 3 players should fail")
+	}
+}
+
+// TDD: Test a two-player game replays from its log
+func TestRebuild_TwoPlayer(t *testing.T) {
+	clock := NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	game := NewGame("sim", WithSeed(3), WithClock(clock), WithRuleSet(TwoPlayerRuleSet()))
+	for i := 0; i < 2; i++ {
+		game.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i)))
+	}
+	game.StartGame()
+
+	for turn := 0; turn < 300 && game.State == Playing; turn++ {
+		clock.Advance(time.Second)
+		moves := game.LegalActions(game.PendingDecision().PlayerIDs[0])
+		if err := game.ApplyMove(moves[(turn*3)%len(moves)]); err != nil {
+			t.Fatalf("ApplyMove() error = %v", err)
+		}
+	}
+
+	rebuilt, err := Rebuild(game.Events(), nil)
+	if err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+	if !reflect.DeepEqual(rebuilt.GetGameState(), game.GetGameState()) {
+		t.Error("Rebuilt two-player game should end in the same state")
+	}
+}
@@ -0,0 +1,115 @@
+package game
+
+import "time"
+
+// EventType identifies what changed in a game event
+type EventType string
+
+const (
+	// Commands: decisions made by the table, re-applied when rebuilding a game
+	EventGameCreated     EventType = "game_created"
+	EventTreasurySized   EventType = "treasury_sized"
+	EventRulesSet        EventType = "rules_set"
+	EventPlayerJoined    EventType = "player_joined"
+	EventPlayerLeft      EventType = "player_left"
+	EventCountdownBegun  EventType = "countdown_begun"
+	EventGameStarted     EventType = "game_started"
+	EventActionDeclared  EventType = "action_declared"
+	EventChallenged      EventType = "challenged"
+	EventPassed          EventType = "passed"
+	EventBlockDeclared   EventType = "block_declared"
+	EventInfluenceChosen EventType = "influence_chosen"
+	EventExchangeChosen  EventType = "exchange_chosen"
+	EventDraftChosen     EventType = "draft_chosen"
+	EventShowChosen      EventType = "show_chosen"
+	EventExamined        EventType = "examined"
+	EventTimeExpired     EventType = "time_expired"
+
+	// Effects: state changes that follow from the commands
+	EventCardsDealt        EventType = "cards_dealt"
+	EventCoinsMoved        EventType = "coins_moved"
+	EventChallengeResolved EventType = "challenge_resolved"
+	EventCardRevealed      EventType = "card_revealed"
+	EventCardsDrawn        EventType = "cards_drawn"
+	EventInfluenceLost     EventType = "influence_lost"
+	EventPlayerEliminated  EventType = "player_eliminated"
+	EventFactionChanged    EventType = "faction_changed"
+	EventCardShown         EventType = "card_shown"
+	EventTurnStarted       EventType = "turn_started"
+	EventGameWon           EventType = "game_won"
+)
+
+// Event is a single state change in a game's append-only log. Fields not relevant
+// to the event type are left empty.
+type Event struct {
+	Seq        int        `json:"seq"`
+	Type       EventType  `json:"type"`
+	At         time.Time  `json:"at"`
+	GameID     string     `json:"game_id,omitempty"`
+	Seed       int64      `json:"seed,omitempty"`
+	PlayerID   string     `json:"player_id,omitempty"` // acting player, or coin payer ("" for the treasury)
+	TargetID   string     `json:"target_id,omitempty"` // targeted player, or coin receiver ("" for the treasury)
+	Name       string     `json:"name,omitempty"`      // player name, new faction, or "reserve" for coins
+	Action     ActionType `json:"action"`
+	Card       Card       `json:"card"`
+	Cards      []Card     `json:"cards,omitempty"`
+	Amount     int        `json:"amount,omitempty"`
+	Successful bool       `json:"successful,omitempty"`
+	Rules      *RuleSet   `json:"rules,omitempty"`
+	StartsAt   *time.Time `json:"starts_at,omitempty"`
+	TimedOut   bool       `json:"timed_out,omitempty"` // the command was made for a player who ran out of time
+}
+
+// IsPrivate reports whether the event reveals cards only its player may see
+func (e Event) IsPrivate() bool {
+	switch e.Type {
+	case EventCardsDealt, EventCardsDrawn, EventExchangeChosen, EventDraftChosen, EventShowChosen, EventCardShown:
+		return true
+	default:
+		return false
+	}
+}
+
+// visibleTo reports whether a player may see the cards of a private event: its own player,
+// and for a shown card the Inquisitor it was shown to
+func (e Event) visibleTo(playerID string) bool {
+	return e.PlayerID == playerID || (e.Type == EventCardShown && e.TargetID == playerID)
+}
+
+// Events returns a copy of the game's event log
+func (g *Game) Events() []Event {
+	events := make([]Event, len(g.log))
+	copy(events, g.log)
+	return events
+}
+
+// EventsFor returns the event log as seen by a player, hiding other players' private cards
+// and the seed, from which the deck order and every hand could be worked out
+func (g *Game) EventsFor(playerID string) []Event {
+	events := g.Events()
+	for i, event := range events {
+		if event.Type == EventGameCreated {
+			events[i].Seed = 0
+		}
+		if event.IsPrivate() && !event.visibleTo(playerID) {
+			events[i].Cards = nil
+		}
+	}
+	return events
+}
+
+// emit appends an event to the game's log
+func (g *Game) emit(event Event) {
+	event.Seq = len(g.log) + 1
+	event.At = g.clock.Now()
+	if isCommand(event.Type) {
+		if event.Type == EventTimeExpired || g.timingOut {
+			// Moves made for a player who ran out of time earn no increment
+			g.chargeTimeBanks("")
+		} else {
+			g.chargeTimeBanks(event.PlayerID)
+		}
+		event.TimedOut = g.timingOut
+	}
+	g.log = append(g.log, event)
+}
@@ -0,0 +1,145 @@
+package game
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// eventTypes lists the types of the events in a log
+func eventTypes(events []Event) []EventType {
+	types := make([]EventType, 0, len(events))
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	return types
+}
+
+// TDD: Test starting a game logs the setup events in order
+func TestGame_Events_Start(t *testing.T) {
+	game := newStartedGame(t, 3)
+	events := game.Events()
+
+	want := []EventType{
+		EventGameCreated,
+		EventPlayerJoined, EventPlayerJoined, EventPlayerJoined,
+		EventGameStarted,
+		EventCoinsMoved, EventCoinsMoved, EventCoinsMoved,
+		EventCardsDealt, EventCardsDealt, EventCardsDealt,
+		EventTurnStarted,
+	}
+	if got := eventTypes(events); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Events() types = %v, want %v", got, want)
+	}
+
+	for i, event := range events {
+		if event.Seq != i+1 {
+			t.Errorf("Event %d Seq = %v, want %v", i, event.Seq, i+1)
+		}
+	}
+
+	if dealt := events[8]; !reflect.DeepEqual(dealt.Cards, game.Players[dealt.PlayerID].Cards) {
+		t.Errorf("CardsDealt cards = %v, want %v", dealt.Cards, game.Players[dealt.PlayerID].Cards)
+	}
+}
+
+// TDD: Test an action logs its declaration, coins and the next turn
+func TestGame_Events_Action(t *testing.T) {
+	game := newStartedGame(t, 3)
+	start := len(game.Events())
+
+	game.PerformAction("p0", Income, "")
+	events := game.Events()[start:]
+
+	want := []EventType{EventActionDeclared, EventCoinsMoved, EventTurnStarted}
+	if got := eventTypes(events); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Events() types = %v, want %v", got, want)
+	}
+
+	if coins := events[1]; coins.PlayerID != "" || coins.TargetID != "p0" || coins.Amount != 1 {
+		t.Errorf("CoinsMoved = %+v, want 1 coin from the treasury to p0", coins)
+	}
+}
+
+// TDD: Test a failed challenge logs the proof, the replacement and the lost influence
+func TestGame_Events_Challenge(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p0"].Cards = []Card{Duke, Captain}
+	game.Players["p1"].Cards = []Card{Contessa}
+	start := len(game.Events())
+
+	game.PerformAction("p0", Tax, "")
+	game.Challenge("p1")
+	events := game.Events()[start:]
+
+	want := []EventType{
+		EventActionDeclared, EventChallenged, EventChallengeResolved,
+		EventCardRevealed, EventCardsDrawn, EventInfluenceLost, EventPlayerEliminated,
+	}
+	if got := eventTypes(events)[:len(want)]; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Events() types = %v, want prefix %v", got, want)
+	}
+
+	if resolved := events[2]; resolved.Successful || resolved.PlayerID != "p0" || resolved.TargetID != "p1" {
+		t.Errorf("ChallengeResolved = %+v, want a failed challenge of p0 by p1", resolved)
+	}
+}
+
+// TDD: Test EventsFor hides other players' private cards
+func TestGame_EventsFor(t *testing.T) {
+	game := newStartedGame(t, 3)
+
+	for _, event := range game.EventsFor("p1") {
+		if event.Type != EventCardsDealt {
+			continue
+		}
+		if event.PlayerID == "p1" && len(event.Cards) != 2 {
+			t.Errorf("EventsFor(p1) own dealt cards = %v, want 2 cards", event.Cards)
+		}
+		if event.PlayerID != "p1" && event.Cards != nil {
+			t.Errorf("EventsFor(p1) shows %s's cards %v", event.PlayerID, event.Cards)
+		}
+	}
+
+	if game.Events()[8].Cards == nil {
+		t.Error("EventsFor() should not modify the game's log")
+	}
+}
+
+// TDD: Test a player's view of the log cannot be used to rebuild another player's hand
+func TestGame_EventsFor_Seed(t *testing.T) {
+	dealt := func(seed int64) []Card {
+		game := NewGame("test", WithSeed(seed))
+		for i := 0; i < 3; i++ {
+			game.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i)))
+		}
+		if err := game.StartGame(); err != nil {
+			t.Fatalf("StartGame() error = %v", err)
+		}
+		return game.Players["p0"].Cards
+	}
+
+	game := NewGame("test", WithSeed(42))
+	for i := 0; i < 3; i++ {
+		game.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i)))
+	}
+	game.StartGame()
+	hand := game.Players["p0"].Cards
+
+	// The full log's seed reproduces the hand, which is why it must not be shared
+	if !reflect.DeepEqual(dealt(game.Events()[0].Seed), hand) {
+		t.Fatal("the seed should reproduce the dealt hands")
+	}
+
+	created := game.EventsFor("p1")[0]
+	if created.Seed != 0 {
+		t.Errorf("EventsFor(p1) seed = %d, want it hidden", created.Seed)
+	}
+	if reflect.DeepEqual(dealt(created.Seed), hand) {
+		t.Error("EventsFor(p1) lets p1 rebuild p0's hand")
+	}
+
+	if _, err := Rebuild(game.EventsFor("p1"), nil); err == nil {
+		t.Error("Rebuild() should fail from a player's view of the log")
+	}
+}
@@ -0,0 +1,109 @@
+package game
+
+import "fmt"
+
+// Examination is an Inquisitor looking at one of another player's cards
+type Examination struct {
+	ActorID  string `json:"actor_id"`
+	TargetID string `json:"target_id"`
+	Card     Card   `json:"-"`
+	Shown    bool   `json:"shown"`
+}
+
+// GetPublicInfo returns the examination details visible to all players
+func (e *Examination) GetPublicInfo() map[string]interface{} {
+	return map[string]interface{}{
+		"actor_id":  e.ActorID,
+		"target_id": e.TargetID,
+		"shown":     e.Shown,
+	}
+}
+
+// GetPrivateInfo returns the peek at the examined card, visible only to the Inquisitor
+func (e *Examination) GetPrivateInfo() map[string]interface{} {
+	return map[string]interface{}{
+		"target_id": e.TargetID,
+		"card":      e.Card.String(),
+	}
+}
+
+// ShowCard shows the Inquisitor the card the examined player picked
+func (g *Game) ShowCard(playerID string, card Card) error {
+	if err := g.requirePhase("show a card", PhaseShowCard); err != nil {
+		return err
+	}
+
+	if g.Examination.TargetID != playerID {
+		return fmt.Errorf("player %s is not being examined", playerID)
+	}
+
+	if !g.Players[playerID].HasCard(card) {
+		return fmt.Errorf("player does not have card: %s", card.String())
+	}
+
+	g.emit(Event{Type: EventShowChosen, PlayerID: playerID, Cards: []Card{card}})
+
+	return g.showCard(card)
+}
+
+// ChooseExamineSwap ends an examination, returning the card to its owner or, when swap is
+// set, forcing them to shuffle it into the deck and draw a replacement
+func (g *Game) ChooseExamineSwap(playerID string, swap bool) error {
+	if err := g.requirePhase("examine", PhaseExamine); err != nil {
+		return err
+	}
+
+	examination := g.Examination
+	if examination.ActorID != playerID {
+		return fmt.Errorf("player %s is not examining a card", playerID)
+	}
+
+	g.emit(Event{Type: EventExamined, PlayerID: playerID, TargetID: examination.TargetID, Successful: swap})
+
+	if swap {
+		if err := g.swapCard(g.Players[examination.TargetID], examination.Card); err != nil {
+			return err
+		}
+	}
+
+	g.Examination = nil
+	return g.finishTurn()
+}
+
+// startExamination asks the target which card to show, or shows their last card straight away
+func (g *Game) startExamination(actor, target *Player) error {
+	g.Examination = &Examination{ActorID: actor.ID, TargetID: target.ID}
+
+	if len(target.Cards) == 1 {
+		return g.showCard(target.Cards[0])
+	}
+	return g.transition(PhaseShowCard)
+}
+
+// showCard reveals the examined card to the Inquisitor and waits for their decision
+func (g *Game) showCard(card Card) error {
+	examination := g.Examination
+	g.emit(Event{Type: EventCardShown, PlayerID: examination.TargetID, TargetID: examination.ActorID, Cards: []Card{card}})
+
+	examination.Card = card
+	examination.Shown = true
+	return g.transition(PhaseExamine)
+}
+
+// swapCard shuffles one of a player's cards into the deck and draws a replacement without
+// revealing either to the table
+func (g *Game) swapCard(player *Player, card Card) error {
+	for i, c := range player.Cards {
+		if c == card {
+			g.Deck = append(g.Deck, card)
+			g.rng.ShuffleCards(g.Deck)
+
+			player.Cards[i] = g.Deck[0]
+			g.Deck = g.Deck[1:]
+
+			g.emit(Event{Type: EventCardsDrawn, PlayerID: player.ID, Cards: []Card{player.Cards[i]}})
+			return nil
+		}
+	}
+	return fmt.Errorf("player does not have card: %s", card.String())
+}
@@ -0,0 +1,197 @@
+package game
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newInquisitorGame creates a started game played with the Inquisitor instead of the Ambassador
+func newInquisitorGame(t *testing.T, playerCount int) *Game {
+	t.Helper()
+
+	rules := DefaultRuleSet()
+	rules.Inquisitor = true
+	return newRuledGame(t, rules, playerCount)
+}
+
+// TDD: Test the Inquisitor replaces the Ambassador in the deck
+func TestGame_Inquisitor_Deck(t *testing.T) {
+	game := newInquisitorGame(t, 3)
+
+	counts := make(map[Card]int)
+	for _, card := range game.Deck {
+		counts[card]++
+	}
+	for _, player := range game.Players {
+		for _, card := range player.Cards {
+			counts[card]++
+		}
+	}
+
+	if counts[Ambassador] != 0 || counts[Inquisitor] != 3 {
+		t.Errorf("Deck has %d Ambassadors and %d Inquisitors, want 0 and 3", counts[Ambassador], counts[Inquisitor])
+	}
+
+	if _, ok := newStartedGame(t, 3).GetGameState()["examination"]; ok {
+		t.Error("GetGameState() should not show an examination when none is running")
+	}
+}
+
+// TDD: Test the Inquisitor exchanges a single card with the deck
+func TestGame_Inquisitor_Exchange(t *testing.T) {
+	game := newInquisitorGame(t, 3)
+
+	game.PerformAction("p0", Exchange, "")
+	if claim := game.PendingAction.GetPublicInfo()["claim"]; claim != "Inquisitor" {
+		t.Errorf("Exchange claim = %v, want Inquisitor", claim)
+	}
+	passAll(t, game)
+
+	if cards := len(game.PendingExchange.Cards); cards != 3 {
+		t.Errorf("Exchange pool = %v cards, want 3", cards)
+	}
+}
+
+// TDD: Test the Inquisitor blocks Steal and the Ambassador cannot be claimed
+func TestGame_Inquisitor_Block(t *testing.T) {
+	game := newInquisitorGame(t, 3)
+
+	game.PerformAction("p0", Steal, "p1")
+	passAll(t, game)
+	game.PerformAction("p1", Steal, "p2")
+	game.PassChallenge("p0")
+	game.PassChallenge("p2")
+
+	if err := game.Block("p2", Ambassador); err == nil {
+		t.Error("Block() with an Ambassador should fail in the Inquisitor variant")
+	}
+
+	if err := game.Block("p2", Inquisitor); err != nil {
+		t.Errorf("Block() with an Inquisitor error = %v", err)
+	}
+}
+
+// TDD: Test examining a card shows it only to the Inquisitor, who may force a swap
+func TestGame_Examine(t *testing.T) {
+	game := newInquisitorGame(t, 3)
+	game.Players["p1"].Cards = []Card{Duke, Captain}
+
+	if err := game.PerformAction("p0", Examine, "p1"); err != nil {
+		t.Fatalf("PerformAction(Examine) error = %v", err)
+	}
+	passAll(t, game)
+
+	if game.Phase != PhaseShowCard || game.PendingDecision().PlayerIDs[0] != "p1" {
+		t.Fatalf("Phase = %v, want p1 to choose a card to show", game.Phase)
+	}
+
+	if err := game.ShowCard("p1", Contessa); err == nil {
+		t.Error("ShowCard() with a card not held should fail")
+	}
+	if err := game.ShowCard("p1", Captain); err != nil {
+		t.Fatalf("ShowCard() error = %v", err)
+	}
+
+	peek, ok := game.GetPlayerGameState("p0")["your_peek"].(map[string]interface{})
+	if !ok || peek["card"] != "Captain" {
+		t.Errorf("Inquisitor peek = %v, want Captain", peek)
+	}
+	if _, ok := game.GetPlayerGameState("p2")["your_peek"]; ok {
+		t.Error("Other players should not see the examined card")
+	}
+
+	for _, event := range game.EventsFor("p2") {
+		if event.Type == EventCardShown && event.Cards != nil {
+			t.Error("EventsFor() should hide the shown card from other players")
+		}
+	}
+
+	if err := game.ChooseExamineSwap("p1", true); err == nil {
+		t.Error("ChooseExamineSwap() by the examined player should fail")
+	}
+	deckSize := len(game.Deck)
+	if err := game.ChooseExamineSwap("p0", true); err != nil {
+		t.Fatalf("ChooseExamineSwap() error = %v", err)
+	}
+
+	if len(game.Players["p1"].Cards) != 2 || len(game.Deck) != deckSize {
+		t.Errorf("After swap cards = %v, deck = %v, want 2 cards and %v in the deck", game.Players["p1"].Cards, len(game.Deck), deckSize)
+	}
+
+	if game.Phase != PhaseAction || game.GetCurrentPlayer().ID != "p1" || game.Examination != nil {
+		t.Errorf("Phase = %v, want the next turn", game.Phase)
+	}
+}
+
+// TDD: Test a player with one card shows it without being asked
+func TestGame_Examine_LastCard(t *testing.T) {
+	game := newInquisitorGame(t, 3)
+	game.Players["p1"].Cards = []Card{Contessa}
+
+	game.PerformAction("p0", Examine, "p1")
+	passAll(t, game)
+
+	if game.Phase != PhaseExamine || game.Examination.Card != Contessa {
+		t.Fatalf("Phase = %v, want the Inquisitor to decide on the Contessa", game.Phase)
+	}
+
+	moves := game.LegalActions("p0")
+	if len(moves) != 2 || moves[0].Kind != MoveExamine {
+		t.Errorf("LegalActions() = %v, want return and swap", moves)
+	}
+
+	if err := game.ApplyMove(moves[0]); err != nil {
+		t.Fatalf("ApplyMove() error = %v", err)
+	}
+	if !reflect.DeepEqual(game.Players["p1"].Cards, []Card{Contessa}) {
+		t.Errorf("Returned card = %v, want [Contessa]", game.Players["p1"].Cards)
+	}
+}
+
+// TDD: Test Examine is only available in the Inquisitor variant
+func TestGame_Examine_OptIn(t *testing.T) {
+	game := newStartedGame(t, 3)
+
+	if err := game.PerformAction("p0", Examine, "p1"); err == nil {
+		t.Error("Examine should fail outside the Inquisitor variant")
+	}
+}
+
+// TDD: Test an Inquisitor game replays from its log
+func TestRebuild_Inquisitor(t *testing.T) {
+	rules := DefaultRuleSet()
+	rules.Inquisitor = true
+
+	clock := NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	game := NewGame("sim", WithSeed(5), WithClock(clock), WithRuleSet(rules))
+	for i := 0; i < 4; i++ {
+		game.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i)))
+	}
+	game.StartGame()
+
+	for turn := 0; turn < 300 && game.State == Playing; turn++ {
+		clock.Advance(time.Second)
+		moves := game.LegalActions(game.PendingDecision().PlayerIDs[0])
+		if err := game.ApplyMove(moves[(turn*7)%len(moves)]); err != nil {
+			t.Fatalf("ApplyMove() error = %v", err)
+		}
+	}
+
+	examined := false
+	for _, event := range game.Events() {
+		examined = examined || event.Type == EventExamined
+	}
+	if !examined {
+		t.Fatal("Simulated game should include an examination")
+	}
+
+	rebuilt, err := Rebuild(game.Events(), nil)
+	if err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+	if !reflect.DeepEqual(rebuilt.GetGameState(), game.GetGameState()) {
+		t.Error("Rebuilt Inquisitor game should end in the same state")
+	}
+}
@@ -0,0 +1,104 @@
+package game
+
+import "fmt"
+
+const (
+	// ExchangeDrawCount is the number of cards an Ambassador draws from the deck
+	ExchangeDrawCount = 2
+	// InquisitorDrawCount is the number of cards an Inquisitor draws from the deck
+	InquisitorDrawCount = 1
+)
+
+// ExchangeChoice holds the cards a player picks from while exchanging with the deck
+type ExchangeChoice struct {
+	PlayerID string `json:"player_id"`
+	Cards    []Card `json:"-"`
+	Keep     int    `json:"keep"`
+}
+
+// GetPrivateInfo returns the exchange details visible only to the exchanging player
+func (ec *ExchangeChoice) GetPrivateInfo() map[string]interface{} {
+	return map[string]interface{}{
+		"cards": cardNames(ec.Cards),
+		"keep":  ec.Keep,
+	}
+}
+
+// ChooseExchangeCards keeps the selected cards, returns the rest to the deck and ends the turn
+func (g *Game) ChooseExchangeCards(playerID string, keep []Card) error {
+	if err := g.requirePhase("exchange cards", PhaseExchange); err != nil {
+		return err
+	}
+
+	choice := g.PendingExchange
+
+	if choice.PlayerID != playerID {
+		return fmt.Errorf("player %s is not exchanging cards", playerID)
+	}
+
+	if len(keep) != choice.Keep {
+		return fmt.Errorf("must keep exactly %d cards, got %d", choice.Keep, len(keep))
+	}
+
+	returned, err := removeCards(choice.Cards, keep)
+	if err != nil {
+		return err
+	}
+
+	g.emit(Event{Type: EventExchangeChosen, PlayerID: playerID, Cards: append([]Card(nil), keep...)})
+
+	player := g.Players[playerID]
+	player.Cards = append(make([]Card, 0, len(keep)), keep...)
+
+	g.Deck = append(g.Deck, returned...)
+	g.rng.ShuffleCards(g.Deck)
+
+	g.PendingExchange = nil
+	return g.finishTurn()
+}
+
+// removeCards returns the pool without the kept cards, failing if the pool does not hold them
+func removeCards(pool, keep []Card) ([]Card, error) {
+	rest := cloneCards(pool)
+
+	for _, card := range keep {
+		index := -1
+		for i, c := range rest {
+			if c == card {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, fmt.Errorf("card %s is not available to keep", card.String())
+		}
+		rest = append(rest[:index], rest[index+1:]...)
+	}
+
+	return rest, nil
+}
+
+// startExchange draws cards from the deck and waits for the player to choose which to keep
+func (g *Game) startExchange(player *Player) error {
+	drawCount := ExchangeDrawCount
+	if g.Rules.RequiredCard(Exchange) == Inquisitor {
+		drawCount = InquisitorDrawCount
+	}
+	if len(g.Deck) < drawCount {
+		drawCount = len(g.Deck)
+	}
+
+	cards := make([]Card, 0, len(player.Cards)+drawCount)
+	cards = append(cards, player.Cards...)
+	cards = append(cards, g.Deck[:drawCount]...)
+	g.Deck = g.Deck[drawCount:]
+
+	g.emit(Event{Type: EventCardsDrawn, PlayerID: player.ID, Cards: append([]Card(nil), cards[len(player.Cards):]...)})
+
+	g.PendingExchange = &ExchangeChoice{
+		PlayerID: player.ID,
+		Cards:    cards,
+		Keep:     len(player.Cards),
+	}
+	return g.transition(PhaseExchange)
+}
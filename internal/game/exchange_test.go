@@ -0,0 +1,92 @@
+package game
+
+import (
+	"testing"
+)
+
+// TDD: Test the two-phase Ambassador exchange
+func TestGame_ChooseExchangeCards(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p0"].Cards = []Card{Duke, Contessa}
+	game.Deck = append([]Card{Captain, Assassin}, game.Deck[2:]...)
+	deckSize := len(game.Deck)
+
+	game.PerformAction("p0", Exchange, "")
+	passAll(t, game)
+
+	choice := game.PendingExchange
+	if choice == nil {
+		t.Fatal("PendingExchange should be set after the exchange resolves")
+	}
+
+	if len(choice.Cards) != 4 || choice.Keep != 2 {
+		t.Errorf("Exchange cards = %v keep %d, want 4 cards keep 2", choice.Cards, choice.Keep)
+	}
+
+	if len(game.Deck) != deckSize-2 {
+		t.Errorf("Deck length during exchange = %v, want %v", len(game.Deck), deckSize-2)
+	}
+
+	state := game.GetPlayerGameState("p0")
+	if _, ok := state["your_exchange"]; !ok {
+		t.Error("Exchanging player's state should contain your_exchange")
+	}
+
+	if _, ok := game.GetPlayerGameState("p1")["your_exchange"]; ok {
+		t.Error("Other players' state should not contain your_exchange")
+	}
+
+	if err := game.ChooseExchangeCards("p1", []Card{Captain, Assassin}); err == nil {
+		t.Error("ChooseExchangeCards() should return error for a player who is not exchanging")
+	}
+
+	if err := game.ChooseExchangeCards("p0", []Card{Captain}); err == nil {
+		t.Error("ChooseExchangeCards() should return error when keeping the wrong number of cards")
+	}
+
+	if err := game.ChooseExchangeCards("p0", []Card{Captain, Captain}); err == nil {
+		t.Error("ChooseExchangeCards() should return error for cards that were not offered")
+	}
+
+	if err := game.ChooseExchangeCards("p0", []Card{Captain, Assassin}); err != nil {
+		t.Fatalf("ChooseExchangeCards() error = %v, want nil", err)
+	}
+
+	player := game.Players["p0"]
+	if len(player.Cards) != 2 || player.Cards[0] != Captain || player.Cards[1] != Assassin {
+		t.Errorf("Cards after exchange = %v, want [Captain Assassin]", player.Cards)
+	}
+
+	if len(game.Deck) != deckSize {
+		t.Errorf("Deck length after exchange = %v, want %v", len(game.Deck), deckSize)
+	}
+
+	if game.PendingExchange != nil || game.PendingAction != nil {
+		t.Error("Exchange should be cleared after choosing cards")
+	}
+
+	if current := game.GetCurrentPlayer(); current.ID != "p1" {
+		t.Errorf("Current player = %v, want p1", current.ID)
+	}
+}
+
+// TDD: Test a player with one influence keeps one card
+func TestGame_Exchange_SingleInfluence(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p0"].Cards = []Card{Ambassador}
+
+	game.PerformAction("p0", Exchange, "")
+	passAll(t, game)
+
+	if game.PendingExchange == nil || game.PendingExchange.Keep != 1 {
+		t.Fatalf("PendingExchange = %v, want keep 1", game.PendingExchange)
+	}
+
+	if err := game.ChooseExchangeCards("p0", game.PendingExchange.Cards[1:3]); err == nil {
+		t.Error("ChooseExchangeCards() should return error when keeping more cards than influences")
+	}
+
+	if err := game.ChooseExchangeCards("p0", game.PendingExchange.Cards[2:3]); err != nil {
+		t.Errorf("ChooseExchangeCards() error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,74 @@
+package game
+
+// Faction is a player's allegiance in the Reformation variant
+type Faction int
+
+const (
+	// NoFaction is the allegiance of every player outside the Reformation variant
+	NoFaction Faction = iota
+	// Loyalist is one of the two Reformation factions
+	Loyalist
+	// Reformist is the other Reformation faction
+	Reformist
+)
+
+// String returns the string representation of a faction
+func (f Faction) String() string {
+	switch f {
+	case Loyalist:
+		return "Loyalist"
+	case Reformist:
+		return "Reformist"
+	default:
+		return "None"
+	}
+}
+
+// Opposite returns the faction a player joins when converted
+func (f Faction) Opposite() Faction {
+	switch f {
+	case Loyalist:
+		return Reformist
+	case Reformist:
+		return Loyalist
+	default:
+		return NoFaction
+	}
+}
+
+// assignFactions alternates the factions around the table
+func (g *Game) assignFactions() {
+	for i, playerID := range g.PlayerOrder {
+		faction := Loyalist
+		if i%2 == 1 {
+			faction = Reformist
+		}
+		g.Players[playerID].Faction = faction
+	}
+}
+
+// factionsMixed reports whether both factions are still represented among the living players.
+// Once everyone shares a faction the targeting restrictions are lifted.
+func (g *Game) factionsMixed() bool {
+	seen := NoFaction
+	for _, player := range g.GetAlivePlayers() {
+		if seen == NoFaction {
+			seen = player.Faction
+		} else if player.Faction != seen {
+			return true
+		}
+	}
+	return false
+}
+
+// sameFactionProtected reports whether two players are shielded from each other's
+// attacks and blocks because they belong to the same faction
+func (g *Game) sameFactionProtected(a, b *Player) bool {
+	return g.Rules.Reformation && a.Faction == b.Faction && g.factionsMixed()
+}
+
+// convert moves a player to the opposite faction
+func (g *Game) convert(player *Player) {
+	player.Faction = player.Faction.Opposite()
+	g.emit(Event{Type: EventFactionChanged, PlayerID: player.ID, Name: player.Faction.String()})
+}
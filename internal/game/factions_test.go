@@ -0,0 +1,157 @@
+package game
+
+import "testing"
+
+// newReformationGame creates a started Reformation game; factions alternate by seat
+func newReformationGame(t *testing.T, playerCount int) *Game {
+	t.Helper()
+
+	rules := DefaultRuleSet()
+	rules.Reformation = true
+	return newRuledGame(t, rules, playerCount)
+}
+
+// TDD: Test factions alternate around the table
+func TestGame_Reformation_Factions(t *testing.T) {
+	game := newReformationGame(t, 4)
+
+	want := []Faction{Loyalist, Reformist, Loyalist, Reformist}
+	for i, id := range game.PlayerOrder {
+		if faction := game.Players[id].Faction; faction != want[i] {
+			t.Errorf("Player %s faction = %v, want %v", id, faction, want[i])
+		}
+	}
+
+	if info := game.Players["p0"].GetPublicInfo(); info["faction"] != "Loyalist" {
+		t.Errorf("GetPublicInfo() faction = %v, want Loyalist", info["faction"])
+	}
+
+	if _, shown := newStartedGame(t, 3).Players["p0"].GetPublicInfo()["faction"]; shown {
+		t.Error("GetPublicInfo() should not show a faction outside the Reformation variant")
+	}
+}
+
+// TDD: Test Reformation actions are opt-in
+func TestGame_Reformation_OptIn(t *testing.T) {
+	game := newStartedGame(t, 3)
+
+	if err := game.PerformAction("p0", Convert, ""); err == nil {
+		t.Error("Convert should fail outside the Reformation variant")
+	}
+
+	for _, move := range game.LegalActions("p0") {
+		if move.Action.IsReformationAction() {
+			t.Errorf("LegalActions() includes %v outside the Reformation variant", move.Action)
+		}
+	}
+}
+
+// TDD: Test players cannot attack their own faction while both factions remain
+func TestGame_Reformation_Targeting(t *testing.T) {
+	game := newReformationGame(t, 4)
+	game.Players["p0"].Coins = 7
+
+	if err := game.PerformAction("p0", Coup, "p2"); err == nil {
+		t.Error("Coup against the same faction should fail")
+	}
+
+	for _, move := range game.LegalActions("p0") {
+		if move.Action.RequiresTarget() && move.TargetID == "p2" {
+			t.Errorf("LegalActions() offers %v against the same faction", move.Action)
+		}
+	}
+
+	// Once everyone is a Loyalist the restriction is lifted
+	game.Players["p1"].Faction = Loyalist
+	game.Players["p3"].Faction = Loyalist
+	if err := game.PerformAction("p0", Coup, "p2"); err != nil {
+		t.Errorf("Coup with a single faction left error = %v", err)
+	}
+}
+
+// TDD: Test players cannot block the Foreign Aid of their own faction
+func TestGame_Reformation_Block(t *testing.T) {
+	game := newReformationGame(t, 4)
+
+	game.PerformAction("p0", ForeignAid, "")
+
+	if err := game.Block("p2", Duke); err == nil {
+		t.Error("Blocking Foreign Aid of the same faction should fail")
+	}
+
+	if err := game.Block("p1", Duke); err != nil {
+		t.Errorf("Blocking Foreign Aid of the other faction error = %v", err)
+	}
+}
+
+// TDD: Test converting pays into the reserve and embezzling empties it
+func TestGame_Reformation_ConvertAndEmbezzle(t *testing.T) {
+	game := newReformationGame(t, 3)
+
+	if err := game.PerformAction("p0", Convert, ""); err != nil {
+		t.Fatalf("PerformAction(Convert) error = %v", err)
+	}
+	if game.Players["p0"].Faction != Reformist || game.Treasury.Reserve != 1 {
+		t.Errorf("Self convert: faction = %v, reserve = %v, want Reformist and 1", game.Players["p0"].Faction, game.Treasury.Reserve)
+	}
+
+	if err := game.PerformAction("p1", Convert, "p2"); err != nil {
+		t.Fatalf("PerformAction(Convert, p2) error = %v", err)
+	}
+	if game.Players["p2"].Faction != Reformist || game.Players["p1"].Coins != 0 || game.Treasury.Reserve != 3 {
+		t.Errorf("Convert other: faction = %v, coins = %v, reserve = %v", game.Players["p2"].Faction, game.Players["p1"].Coins, game.Treasury.Reserve)
+	}
+
+	game.Players["p2"].Cards = []Card{Captain, Contessa}
+	if err := game.PerformAction("p2", Embezzle, ""); err != nil {
+		t.Fatalf("PerformAction(Embezzle) error = %v", err)
+	}
+	passAll(t, game)
+
+	if game.Players["p2"].Coins != 5 || game.Treasury.Reserve != 0 {
+		t.Errorf("Embezzle: coins = %v, reserve = %v, want 5 and 0", game.Players["p2"].Coins, game.Treasury.Reserve)
+	}
+
+	if err := game.CheckCoinInvariant(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TDD: Test challenging an Embezzle succeeds only when the claimant holds a Duke
+func TestGame_Reformation_ChallengeEmbezzle(t *testing.T) {
+	game := newReformationGame(t, 3)
+	game.Treasury.Reserve = 2
+	game.Treasury.Coins -= 2
+	game.Players["p0"].Cards = []Card{Duke, Captain}
+
+	game.PerformAction("p0", Embezzle, "")
+	outcome, err := game.Challenge("p1")
+	if err != nil {
+		t.Fatalf("Challenge() error = %v", err)
+	}
+
+	if !outcome.Successful || outcome.MessageID() != "denial_challenge_success" {
+		t.Errorf("Challenge() of a Duke holder = %+v, want successful", outcome)
+	}
+	chooseLoss(t, game, "p0")
+
+	if game.Treasury.Reserve != 2 {
+		t.Errorf("Reserve = %v, want 2 (embezzle cancelled)", game.Treasury.Reserve)
+	}
+
+	game.PerformAction("p1", Income, "")
+	game.PerformAction("p2", Income, "")
+
+	game.Players["p0"].Cards = []Card{Contessa}
+	game.PerformAction("p0", Embezzle, "")
+	outcome, _ = game.Challenge("p1")
+
+	if outcome.Successful || outcome.MessageID() != "denial_challenge_failed" {
+		t.Errorf("Challenge() without a Duke = %+v, want failed", outcome)
+	}
+	chooseLoss(t, game, "p1")
+
+	if game.Treasury.Reserve != 0 || len(game.Players["p0"].Cards) != 1 {
+		t.Errorf("Reserve = %v, cards = %v, want the embezzle resolved", game.Treasury.Reserve, game.Players["p0"].Cards)
+	}
+}
@@ -31,36 +31,80 @@ func (gs GameState) String() string {
 	}
 }
 
+// MaxTablePlayers is the largest table the deck can be scaled for
+const MaxTablePlayers = 10
+
 // Game represents a Coup game instance
 type Game struct {
-	ID            string             `json:"id"`
-	State         GameState          `json:"state"`
-	Players       map[string]*Player `json:"players"`
-	PlayerOrder   []string           `json:"player_order"`
-	CurrentPlayer int                `json:"current_player"`
-	Deck          []Card             `json:"-"`
-	DiscardPile   []Card             `json:"-"`
-	CreatedAt     time.Time          `json:"created_at"`
-	StartedAt     *time.Time         `json:"started_at,omitempty"`
-	FinishedAt    *time.Time         `json:"finished_at,omitempty"`
-	Winner        *Player            `json:"winner,omitempty"`
-	MinPlayers    int                `json:"min_players"`
-	MaxPlayers    int                `json:"max_players"`
-}
-
-// NewGame creates a new Coup game instance
-func NewGame(id string) *Game {
-	return &Game{
+	ID              string             `json:"id"`
+	State           GameState          `json:"state"`
+	Players         map[string]*Player `json:"players"`
+	PlayerOrder     []string           `json:"player_order"`
+	CurrentPlayer   int                `json:"current_player"`
+	Deck            []Card             `json:"-"`
+	DiscardPile     []Card             `json:"-"`
+	Treasury        *Treasury          `json:"treasury"`
+	CreatedAt       time.Time          `json:"created_at"`
+	StartsAt        *time.Time         `json:"starts_at,omitempty"`
+	StartedAt       *time.Time         `json:"started_at,omitempty"`
+	FinishedAt      *time.Time         `json:"finished_at,omitempty"`
+	Winner          *Player            `json:"winner,omitempty"`
+	PendingAction   *PendingAction     `json:"pending_action,omitempty"`
+	Phase           TurnPhase          `json:"phase"`
+	InfluenceLoss   *InfluenceLoss     `json:"influence_loss,omitempty"`
+	PendingExchange *ExchangeChoice    `json:"pending_exchange,omitempty"`
+	PendingDraft    *Draft             `json:"pending_draft,omitempty"`
+	Examination     *Examination       `json:"examination,omitempty"`
+	MinPlayers      int                `json:"min_players"`
+	MaxPlayers      int                `json:"max_players"`
+	Rules           RuleSet            `json:"rules"`
+	Round           int                `json:"round"`
+
+	rng          *Random
+	timeoutRng   *Random // picks the cards of players who time out, apart from the shuffles
+	clock        Clock
+	log          []Event
+	phaseStarted time.Time
+	timingOut    bool
+
+	bankedAt      time.Time // when the time banks were last charged
+	timePenalties []string  // players who ran out of time and pay for it when the turn ends
+}
+
+// NewGame creates a new Coup game instance. Without options the game uses the system clock
+// and a seed taken from it; pass WithSeed and WithClock to make the game reproducible.
+func NewGame(id string, opts ...Option) *Game {
+	g := &Game{
 		ID:          id,
 		State:       Waiting,
 		Players:     make(map[string]*Player),
 		PlayerOrder: make([]string, 0),
 		Deck:        GetAllCards(),
 		DiscardPile: make([]Card, 0),
-		CreatedAt:   time.Now(),
-		MinPlayers:  3,
-		MaxPlayers:  6,
+		Treasury:    NewTreasury(DefaultTreasurySize),
+		clock:       SystemClock{},
+	}
+	g.applyRuleSet(DefaultRuleSet())
+
+	for _, opt := range opts {
+		opt(g)
 	}
+
+	if g.rng == nil {
+		g.rng = NewRandom(g.clock.Now().UnixNano())
+	}
+	g.timeoutRng = NewRandom(^g.rng.Seed())
+	g.CreatedAt = g.clock.Now()
+
+	rules := g.Rules
+	g.emit(Event{Type: EventGameCreated, GameID: id, Seed: g.rng.Seed(), Amount: g.Treasury.Size, Rules: &rules})
+
+	return g
+}
+
+// Seed returns the seed all of the game's shuffles derive from
+func (g *Game) Seed() int64 {
+	return g.rng.Seed()
 }
 
 // AddPlayer adds a player to the game
@@ -80,6 +124,8 @@ func (g *Game) AddPlayer(player *Player) error {
 	g.Players[player.ID] = player
 	g.PlayerOrder = append(g.PlayerOrder, player.ID)
 
+	g.emit(Event{Type: EventPlayerJoined, PlayerID: player.ID, Name: player.Name})
+
 	return nil
 }
 
@@ -90,12 +136,20 @@ func (g *Game) RemovePlayer(playerID string) error {
 		return fmt.Errorf("player with ID %s not found", playerID)
 	}
 
+	g.emit(Event{Type: EventPlayerLeft, PlayerID: playerID})
+
 	// If game is playing, mark as inactive instead of removing
 	if g.State == Playing {
 		player.IsActive = false
 		return nil
 	}
 
+	// A player leaving during the countdown sends the game back to waiting
+	if g.CountingDown() {
+		g.State = Waiting
+		g.StartsAt = nil
+	}
+
 	// Remove from players map
 	delete(g.Players, playerID)
 
@@ -112,35 +166,111 @@ func (g *Game) RemovePlayer(playerID string) error {
 
 // CanStart checks if the game can be started
 func (g *Game) CanStart() bool {
-	return g.State == Waiting && len(g.Players) >= g.MinPlayers
+	return (g.State == Waiting || g.CountingDown()) && len(g.Players) >= g.MinPlayers
+}
+
+// BeginCountdown holds a game that is ready to start in the Starting state until
+// startsAt. No players can join during the countdown, and one leaving cancels it;
+// StartGame deals the cards once it ends.
+func (g *Game) BeginCountdown(startsAt time.Time) error {
+	if g.State != Waiting {
+		return fmt.Errorf("cannot begin countdown: game is %s", g.State)
+	}
+	if err := g.checkStart(); err != nil {
+		return err
+	}
+
+	g.State = Starting
+	g.StartsAt = &startsAt
+	g.emit(Event{Type: EventCountdownBegun, StartsAt: &startsAt})
+
+	return nil
+}
+
+// CountingDown reports whether the game is waiting out its countdown to start
+func (g *Game) CountingDown() bool {
+	return g.State == Starting && g.StartsAt != nil
 }
 
 // StartGame starts the game by shuffling deck and dealing cards
 func (g *Game) StartGame() error {
+	if err := g.checkStart(); err != nil {
+		return err
+	}
+
+	g.State = Starting
+	g.StartsAt = nil
+	g.emit(Event{Type: EventGameStarted})
+
+	return g.dealGame()
+}
+
+// checkStart checks that the players and rules allow the game to start
+func (g *Game) checkStart() error {
 	if !g.CanStart() {
 		return fmt.Errorf("cannot start game: need at least %d players", g.MinPlayers)
 	}
 
-	g.State = Starting
+	if err := g.Rules.Validate(); err != nil {
+		return fmt.Errorf("cannot start game: %v", err)
+	}
 
-	// Shuffle the deck
-	ShuffleCards(g.Deck)
+	deckSize := CopiesPerCharacter(len(g.Players)) * len(g.Rules.Characters())
+	if deckSize < g.Rules.HandSize*len(g.Players) {
+		return fmt.Errorf("deck of %d cards cannot deal %d cards to %d players", deckSize, g.Rules.HandSize, len(g.Players))
+	}
+
+	if g.Rules.TwoPlayer && len(g.Players) != 2 {
+		return fmt.Errorf("cannot start game: two-player rules need exactly 2 players")
+	}
 
-	// Deal 2 cards to each player
+	if g.Treasury.Size < g.Rules.StartingCoins*len(g.Players) {
+		return fmt.Errorf("treasury of %d coins cannot pay starting coins to %d players", g.Treasury.Size, len(g.Players))
+	}
+
+	return nil
+}
+
+// dealGame deals the starting coins and cards and opens the first turn
+func (g *Game) dealGame() error {
+	g.dealStartingCoins()
+	g.dealTimeBanks()
+
+	if g.Rules.Reformation {
+		g.assignFactions()
+	}
+
+	if g.Rules.TwoPlayer {
+		return g.startDraft()
+	}
+
+	// Build a deck sized for the table and shuffle it
+	g.Deck = buildDeck(CopiesPerCharacter(len(g.Players)), g.Rules.Characters())
+	g.rng.ShuffleCards(g.Deck)
+
+	// Deal a hand to each player
 	for _, playerID := range g.PlayerOrder {
 		player := g.Players[playerID]
+		player.handSize = g.Rules.HandSize
 		if err := DealCards(player, &g.Deck); err != nil {
 			return fmt.Errorf("failed to deal cards to player %s: %v", playerID, err)
 		}
+		g.emit(Event{Type: EventCardsDealt, PlayerID: playerID, Cards: append([]Card(nil), player.Cards...)})
 	}
 
 	// Set first player
 	g.CurrentPlayer = 0
+	g.Round = 1
 	g.State = Playing
+	if err := g.transition(PhaseAction); err != nil {
+		return err
+	}
 
-	now := time.Now()
+	now := g.clock.Now()
 	g.StartedAt = &now
 
+	g.emit(Event{Type: EventTurnStarted, PlayerID: g.PlayerOrder[g.CurrentPlayer]})
+
 	return nil
 }
 
@@ -160,9 +290,12 @@ func (g *Game) NextTurn() {
 		return
 	}
 
-	// Find next alive player
+	// Find next alive player, starting a new round each time play passes the first seat
 	for i := 0; i < len(g.PlayerOrder); i++ {
 		g.CurrentPlayer = (g.CurrentPlayer + 1) % len(g.PlayerOrder)
+		if g.CurrentPlayer == 0 {
+			g.Round++
+		}
 		playerID := g.PlayerOrder[g.CurrentPlayer]
 		if g.Players[playerID].IsAlive {
 			break
@@ -171,6 +304,10 @@ func (g *Game) NextTurn() {
 
 	// Check if game should end
 	g.CheckGameEnd()
+
+	if g.State == Playing {
+		g.emit(Event{Type: EventTurnStarted, PlayerID: g.PlayerOrder[g.CurrentPlayer]})
+	}
 }
 
 // CheckGameEnd checks if the game should end and sets winner
@@ -183,20 +320,27 @@ func (g *Game) CheckGameEnd() {
 
 	if len(alivePlayers) <= 1 {
 		g.State = Finished
-		now := time.Now()
+		g.Phase = PhaseNone
+		g.PendingAction = nil
+		g.InfluenceLoss = nil
+		g.PendingExchange = nil
+		g.PendingDraft = nil
+		g.Examination = nil
+		now := g.clock.Now()
 		g.FinishedAt = &now
 
 		if len(alivePlayers) == 1 {
 			g.Winner = alivePlayers[0]
+			g.emit(Event{Type: EventGameWon, PlayerID: g.Winner.ID})
 		}
 	}
 }
 
-// GetAlivePlayers returns all players still in the game
+// GetAlivePlayers returns all players still in the game, in seat order
 func (g *Game) GetAlivePlayers() []*Player {
 	var alive []*Player
-	for _, player := range g.Players {
-		if player.IsAlive {
+	for _, playerID := range g.PlayerOrder {
+		if player := g.Players[playerID]; player.IsAlive {
 			alive = append(alive, player)
 		}
 	}
@@ -213,15 +357,45 @@ func (g *Game) GetGameState() map[string]interface{} {
 	state := map[string]interface{}{
 		"id":             g.ID,
 		"state":          g.State.String(),
+		"phase":          g.Phase.String(),
 		"players":        players,
 		"current_player": "",
 		"deck_size":      len(g.Deck),
+		"discard_pile":   cardNames(g.DiscardPile),
+		"treasury":       g.Treasury.Coins,
+		"rules":          g.Rules,
+		"reserve":        g.Treasury.Reserve,
+		"round":          g.Round,
+	}
+
+	if g.StartsAt != nil {
+		state["starts_at"] = *g.StartsAt
 	}
 
 	if currentPlayer := g.GetCurrentPlayer(); currentPlayer != nil {
 		state["current_player"] = currentPlayer.ID
 	}
 
+	if g.PendingAction != nil {
+		state["pending_action"] = g.PendingAction.GetPublicInfo()
+	}
+
+	if g.InfluenceLoss != nil {
+		state["influence_loss"] = g.InfluenceLoss.PlayerID
+	}
+
+	if g.PendingExchange != nil {
+		state["exchanging_player"] = g.PendingExchange.PlayerID
+	}
+
+	if g.Examination != nil {
+		state["examination"] = g.Examination.GetPublicInfo()
+	}
+
+	if decision := g.PendingDecision(); decision != nil {
+		state["pending_decision"] = decision.GetPublicInfo()
+	}
+
 	if g.Winner != nil {
 		state["winner"] = g.Winner.GetPublicInfo()
 	}
@@ -237,6 +411,22 @@ func (g *Game) GetPlayerGameState(playerID string) map[string]interface{} {
 	if player, exists := g.Players[playerID]; exists {
 		state["your_info"] = player.GetPrivateInfo()
 		state["your_turn"] = g.GetCurrentPlayer() != nil && g.GetCurrentPlayer().ID == playerID
+
+		if decision := g.GetDecisionInfo(playerID); decision != nil {
+			state["your_decision"] = decision
+		}
+
+		if g.PendingExchange != nil && g.PendingExchange.PlayerID == playerID {
+			state["your_exchange"] = g.PendingExchange.GetPrivateInfo()
+		}
+
+		if g.PendingDraft != nil && g.PendingDraft.Includes(playerID) {
+			state["your_draft"] = g.PendingDraft.GetPrivateInfo(playerID)
+		}
+
+		if g.Examination != nil && g.Examination.Shown && g.Examination.ActorID == playerID {
+			state["your_peek"] = g.Examination.GetPrivateInfo()
+		}
 	}
 
 	return state
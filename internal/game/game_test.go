@@ -3,6 +3,7 @@ package game
 import (
 	"fmt"
 	"testing"
+	"time"
 )
 
 // TDD: Test game creation
@@ -29,8 +30,8 @@ func TestNewGame(t *testing.T) {
 		t.Errorf("NewGame() MinPlayers = %v, want 3", game.MinPlayers)
 	}
 
-	if game.MaxPlayers != 6 {
-		t.Errorf("NewGame() MaxPlayers = %v, want 6", game.MaxPlayers)
+	if game.MaxPlayers != 10 {
+		t.Errorf("NewGame() MaxPlayers = %v, want 10", game.MaxPlayers)
 	}
 }
 
@@ -189,6 +190,64 @@ func TestGame_StartGame(t *testing.T) {
 	}
 }
 
+// TDD: Test a game counts down in the Starting state before it is dealt
+func TestGame_BeginCountdown(t *testing.T) {
+	game := NewGame("test", WithSeed(7))
+	game.AddPlayer(NewPlayer("p0", "Player 0"))
+	startsAt := time.Date(2024, 1, 1, 12, 0, 5, 0, time.UTC)
+
+	if err := game.BeginCountdown(startsAt); err == nil {
+		t.Error("BeginCountdown() with too few players should fail")
+	}
+
+	for i := 1; i < 3; i++ {
+		game.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i)))
+	}
+	if err := game.BeginCountdown(startsAt); err != nil {
+		t.Fatalf("BeginCountdown() error = %v", err)
+	}
+	if game.State != Starting || !game.CountingDown() || !game.StartsAt.Equal(startsAt) {
+		t.Fatalf("State = %v, StartsAt = %v, want Starting until %v", game.State, game.StartsAt, startsAt)
+	}
+	if err := game.AddPlayer(NewPlayer("p3", "Player 3")); err == nil {
+		t.Error("AddPlayer() during the countdown should fail")
+	}
+	if err := game.BeginCountdown(startsAt); err == nil {
+		t.Error("BeginCountdown() twice should fail")
+	}
+
+	if err := game.StartGame(); err != nil {
+		t.Fatalf("StartGame() error = %v", err)
+	}
+	if game.State != Playing || game.StartsAt != nil {
+		t.Errorf("State = %v, StartsAt = %v, want Playing with no countdown", game.State, game.StartsAt)
+	}
+
+	rebuilt, err := Rebuild(game.Events(), nil)
+	if err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+	if rebuilt.State != Playing {
+		t.Errorf("Rebuilt state = %v, want Playing", rebuilt.State)
+	}
+}
+
+// TDD: Test a player leaving during the countdown sends the game back to waiting
+func TestGame_BeginCountdown_PlayerLeft(t *testing.T) {
+	game := NewGame("test")
+	for i := 0; i < 3; i++ {
+		game.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i)))
+	}
+	game.BeginCountdown(time.Date(2024, 1, 1, 12, 0, 5, 0, time.UTC))
+
+	if err := game.RemovePlayer("p2"); err != nil {
+		t.Fatalf("RemovePlayer() error = %v", err)
+	}
+	if game.State != Waiting || game.StartsAt != nil || len(game.Players) != 2 {
+		t.Errorf("State = %v with %d players, want Waiting with 2", game.State, len(game.Players))
+	}
+}
+
 // TDD: Test getting current player
 func TestGame_GetCurrentPlayer(t *testing.T) {
 	game := NewGame("test")
@@ -356,3 +415,28 @@ func TestGame_GetPlayerGameState(t *testing.T) {
 		t.Error("Player game state should contain your_turn")
 	}
 }
+
+// TDD: Test a full table gets a larger deck
+func TestGame_StartGame_TenPlayers(t *testing.T) {
+	game := NewGame("test")
+
+	for i := 0; i < 10; i++ {
+		player := NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i))
+		if err := game.AddPlayer(player); err != nil {
+			t.Fatalf("AddPlayer(%d) error = %v, want nil", i, err)
+		}
+	}
+
+	if err := game.AddPlayer(NewPlayer("p10", "Player 10")); err == nil {
+		t.Error("AddPlayer() should return error for an eleventh player")
+	}
+
+	if err := game.StartGame(); err != nil {
+		t.Fatalf("StartGame() error = %v, want nil", err)
+	}
+
+	// 25 cards minus 2 dealt to each of the 10 players
+	if len(game.Deck) != 5 {
+		t.Errorf("Deck length = %v, want 5", len(game.Deck))
+	}
+}
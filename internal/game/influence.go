@@ -0,0 +1,90 @@
+package game
+
+import "fmt"
+
+// afterLoss identifies how the turn continues once an influence loss is settled
+type afterLoss int
+
+const (
+	// afterLossFinish ends the turn
+	afterLossFinish afterLoss = iota
+	// afterLossBlock moves the pending action on to its block phase
+	afterLossBlock
+	// afterLossResolve resolves the pending action
+	afterLossResolve
+)
+
+// InfluenceLoss is a decision owed by a player who must reveal one of their cards
+type InfluenceLoss struct {
+	PlayerID string `json:"player_id"`
+	next     afterLoss
+}
+
+// ChooseInfluenceLoss reveals the card the player picked to lose and continues the turn
+func (g *Game) ChooseInfluenceLoss(playerID string, card Card) error {
+	if err := g.requirePhase("lose influence", PhaseInfluenceLoss); err != nil {
+		return err
+	}
+
+	loss := g.InfluenceLoss
+	if loss.PlayerID != playerID {
+		return fmt.Errorf("player %s does not owe an influence loss", playerID)
+	}
+
+	if !g.Players[playerID].HasCard(card) {
+		return fmt.Errorf("player does not have card: %s", card.String())
+	}
+
+	g.emit(Event{Type: EventInfluenceChosen, PlayerID: playerID, Card: card})
+
+	if err := g.revealCard(g.Players[playerID], card); err != nil {
+		return err
+	}
+
+	g.InfluenceLoss = nil
+	return g.continueAfterLoss(loss.next)
+}
+
+// requireInfluenceLoss makes a player lose one influence, asking them which card to reveal
+// when they still hold more than one; the turn continues with next once the loss is settled
+func (g *Game) requireInfluenceLoss(player *Player, next afterLoss) error {
+	if len(player.Cards) > 1 {
+		g.InfluenceLoss = &InfluenceLoss{PlayerID: player.ID, next: next}
+		return g.transition(PhaseInfluenceLoss)
+	}
+
+	if len(player.Cards) == 1 {
+		if err := g.revealCard(player, player.Cards[0]); err != nil {
+			return err
+		}
+	}
+
+	return g.continueAfterLoss(next)
+}
+
+// revealCard turns one of the player's cards face up and records it in the discard pile
+func (g *Game) revealCard(player *Player, card Card) error {
+	if err := player.RevealCard(card); err != nil {
+		return err
+	}
+
+	g.DiscardPile = append(g.DiscardPile, card)
+	g.emit(Event{Type: EventInfluenceLost, PlayerID: player.ID, Card: card})
+
+	if !player.IsAlive {
+		g.emit(Event{Type: EventPlayerEliminated, PlayerID: player.ID})
+	}
+	return nil
+}
+
+// continueAfterLoss resumes the turn once an influence loss is settled
+func (g *Game) continueAfterLoss(next afterLoss) error {
+	switch next {
+	case afterLossBlock:
+		return g.openBlockPhase()
+	case afterLossResolve:
+		return g.resolvePendingAction()
+	default:
+		return g.finishTurn()
+	}
+}
@@ -0,0 +1,117 @@
+package game
+
+import (
+	"testing"
+)
+
+// chooseLoss makes the player owing an influence loss reveal their first card
+func chooseLoss(t *testing.T, game *Game, playerID string) {
+	t.Helper()
+
+	player := game.Players[playerID]
+	if err := game.ChooseInfluenceLoss(playerID, player.Cards[0]); err != nil {
+		t.Fatalf("ChooseInfluenceLoss(%s) error = %v", playerID, err)
+	}
+}
+
+// TDD: Test the target picks which card a coup reveals
+func TestGame_ChooseInfluenceLoss(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p0"].Coins = 7
+	game.Players["p1"].Cards = []Card{Duke, Contessa}
+
+	game.PerformAction("p0", Coup, "p1")
+
+	if game.InfluenceLoss == nil || game.InfluenceLoss.PlayerID != "p1" {
+		t.Fatalf("InfluenceLoss = %v, want p1", game.InfluenceLoss)
+	}
+
+	if current := game.GetCurrentPlayer(); current.ID != "p0" {
+		t.Errorf("Turn should not advance before the loss is chosen, current = %v", current.ID)
+	}
+
+	if err := game.PerformAction("p0", Income, ""); err == nil {
+		t.Error("PerformAction() should return error while an influence loss is pending")
+	}
+
+	if err := game.ChooseInfluenceLoss("p2", Duke); err == nil {
+		t.Error("ChooseInfluenceLoss() should return error for a player who owes nothing")
+	}
+
+	if err := game.ChooseInfluenceLoss("p1", Captain); err == nil {
+		t.Error("ChooseInfluenceLoss() should return error for a card the player does not hold")
+	}
+
+	if err := game.ChooseInfluenceLoss("p1", Contessa); err != nil {
+		t.Fatalf("ChooseInfluenceLoss() error = %v, want nil", err)
+	}
+
+	player := game.Players["p1"]
+	if len(player.Cards) != 1 || player.Cards[0] != Duke {
+		t.Errorf("Cards = %v, want [Duke]", player.Cards)
+	}
+
+	if len(player.RevealedCards) != 1 || player.RevealedCards[0] != Contessa {
+		t.Errorf("RevealedCards = %v, want [Contessa]", player.RevealedCards)
+	}
+
+	if len(game.DiscardPile) != 1 || game.DiscardPile[0] != Contessa {
+		t.Errorf("DiscardPile = %v, want [Contessa]", game.DiscardPile)
+	}
+
+	if game.InfluenceLoss != nil {
+		t.Error("InfluenceLoss should be cleared")
+	}
+
+	if current := game.GetCurrentPlayer(); current.ID != "p1" {
+		t.Errorf("Current player = %v, want p1", current.ID)
+	}
+}
+
+// TDD: Test a player with a single card loses it without a decision
+func TestGame_InfluenceLoss_LastCard(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p0"].Coins = 7
+	game.Players["p1"].Cards = []Card{Captain}
+
+	game.PerformAction("p0", Coup, "p1")
+
+	if game.InfluenceLoss != nil {
+		t.Error("InfluenceLoss should not be required for a player's last card")
+	}
+
+	player := game.Players["p1"]
+	if player.IsAlive {
+		t.Error("Player should be eliminated after losing the last card")
+	}
+
+	if len(player.RevealedCards) != 1 || player.RevealedCards[0] != Captain {
+		t.Errorf("RevealedCards = %v, want [Captain]", player.RevealedCards)
+	}
+}
+
+// TDD: Test a failed block challenge and the assassination both cost the target influence
+func TestGame_InfluenceLoss_AfterBlockChallenge(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p0"].Coins = 3
+	game.Players["p1"].Cards = []Card{Duke, Captain}
+
+	game.PerformAction("p0", Assassinate, "p1")
+	game.PassChallenge("p1")
+	game.PassChallenge("p2")
+	game.Block("p1", Contessa)
+	game.Challenge("p0")
+
+	if err := game.ChooseInfluenceLoss("p1", Duke); err != nil {
+		t.Fatalf("ChooseInfluenceLoss() error = %v, want nil", err)
+	}
+
+	player := game.Players["p1"]
+	if player.IsAlive {
+		t.Error("Target should be eliminated by the bluffed block and the assassination")
+	}
+
+	if len(player.RevealedCards) != 2 {
+		t.Errorf("RevealedCards length = %v, want 2", len(player.RevealedCards))
+	}
+}
@@ -0,0 +1,153 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+)
+
+// LegalActions lists every move the player may legally make right now
+func (g *Game) LegalActions(playerID string) []Move {
+	moves := make([]Move, 0)
+
+	player, exists := g.Players[playerID]
+	if !exists || !player.IsAlive || g.State != Playing {
+		return moves
+	}
+
+	switch g.Phase {
+	case PhaseAction:
+		if current := g.GetCurrentPlayer(); current.ID == playerID {
+			moves = g.legalTurnActions(player)
+		}
+	case PhaseChallenge, PhaseBlockChallenge:
+		if g.canStillRespond(playerID) {
+			moves = append(moves,
+				Move{Kind: MoveChallenge, PlayerID: playerID},
+				Move{Kind: MovePass, PlayerID: playerID},
+			)
+		}
+	case PhaseBlock:
+		if g.canStillRespond(playerID) {
+			for _, card := range g.blockingCards(g.PendingAction.Action) {
+				moves = append(moves, Move{Kind: MoveBlock, PlayerID: playerID, Card: card})
+			}
+			moves = append(moves, Move{Kind: MovePass, PlayerID: playerID})
+		}
+	case PhaseInfluenceLoss:
+		if g.InfluenceLoss.PlayerID == playerID {
+			for _, card := range distinctCards(player.Cards) {
+				moves = append(moves, Move{Kind: MoveLoseInfluence, PlayerID: playerID, Card: card})
+			}
+		}
+	case PhaseDraft:
+		if g.PendingDraft.Includes(playerID) {
+			for _, keep := range cardCombinations(g.PendingDraft.Pools[playerID], g.PendingDraft.Keep) {
+				moves = append(moves, Move{Kind: MoveDraft, PlayerID: playerID, Cards: keep})
+			}
+		}
+	case PhaseShowCard:
+		if g.Examination.TargetID == playerID {
+			for _, card := range distinctCards(player.Cards) {
+				moves = append(moves, Move{Kind: MoveShowCard, PlayerID: playerID, Card: card})
+			}
+		}
+	case PhaseExamine:
+		if g.Examination.ActorID == playerID {
+			moves = append(moves,
+				Move{Kind: MoveExamine, PlayerID: playerID},
+				Move{Kind: MoveExamine, PlayerID: playerID, Swap: true},
+			)
+		}
+	case PhaseExchange:
+		if g.PendingExchange.PlayerID == playerID {
+			for _, keep := range cardCombinations(g.PendingExchange.Cards, g.PendingExchange.Keep) {
+				moves = append(moves, Move{Kind: MoveExchange, PlayerID: playerID, Cards: keep})
+			}
+		}
+	}
+
+	return moves
+}
+
+// legalTurnActions lists the actions the current player can afford, with every valid target
+func (g *Game) legalTurnActions(player *Player) []Move {
+	moves := make([]Move, 0)
+
+	for action := Income; action <= Examine; action++ {
+		if !g.Rules.ActionEnabled(action) {
+			continue
+		}
+		if g.Rules.MustCoup(player) && action != Coup {
+			continue
+		}
+
+		if !action.RequiresTarget() && player.Coins >= g.actionCost(action, "") {
+			moves = append(moves, Move{Kind: MoveAction, PlayerID: player.ID, Action: action})
+		}
+		if !action.AcceptsTarget() {
+			continue
+		}
+
+		for _, targetID := range g.PlayerOrder {
+			target := g.Players[targetID]
+			if targetID == player.ID || !target.IsAlive || player.Coins < g.actionCost(action, targetID) {
+				continue
+			}
+			if action.RequiresTarget() && g.sameFactionProtected(player, target) {
+				continue
+			}
+			moves = append(moves, Move{Kind: MoveAction, PlayerID: player.ID, Action: action, TargetID: targetID})
+		}
+	}
+
+	return moves
+}
+
+// canStillRespond reports whether the player owes a response in the current phase
+func (g *Game) canStillRespond(playerID string) bool {
+	return g.canRespond(g.PendingAction, playerID) && !g.PendingAction.Passed[playerID]
+}
+
+// distinctCards returns the cards without duplicates, in their original order
+func distinctCards(cards []Card) []Card {
+	seen := make(map[Card]bool)
+	distinct := make([]Card, 0, len(cards))
+	for _, card := range cards {
+		if !seen[card] {
+			seen[card] = true
+			distinct = append(distinct, card)
+		}
+	}
+	return distinct
+}
+
+// cardCombinations returns every distinct selection of size cards from the pool
+func cardCombinations(pool []Card, size int) [][]Card {
+	combinations := make([][]Card, 0)
+	seen := make(map[string]bool)
+
+	var pick func(start int, chosen []Card)
+	pick = func(start int, chosen []Card) {
+		if len(chosen) == size {
+			key := combinationKey(chosen)
+			if !seen[key] {
+				seen[key] = true
+				combinations = append(combinations, append([]Card(nil), chosen...))
+			}
+			return
+		}
+		for i := start; i < len(pool); i++ {
+			pick(i+1, append(chosen, pool[i]))
+		}
+	}
+	pick(0, make([]Card, 0, size))
+
+	return combinations
+}
+
+// combinationKey identifies a selection of cards regardless of their order
+func combinationKey(cards []Card) string {
+	sorted := append([]Card(nil), cards...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return fmt.Sprint(sorted)
+}
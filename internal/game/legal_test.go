@@ -0,0 +1,112 @@
+package game
+
+import (
+	"testing"
+)
+
+// countMoves counts the moves of a given kind
+func countMoves(moves []Move, kind MoveKind) int {
+	count := 0
+	for _, move := range moves {
+		if move.Kind == kind {
+			count++
+		}
+	}
+	return count
+}
+
+// TDD: Test legal actions on a player's turn
+func TestGame_LegalActions_Turn(t *testing.T) {
+	game := newStartedGame(t, 3)
+
+	// Income, Foreign Aid, Tax, Exchange plus Steal against both opponents
+	moves := game.LegalActions("p0")
+	if len(moves) != 6 {
+		t.Errorf("LegalActions() length = %v, want 6: %+v", len(moves), moves)
+	}
+
+	if len(game.LegalActions("p1")) != 0 {
+		t.Error("LegalActions() should be empty for a player waiting for their turn")
+	}
+
+	game.Players["p0"].Coins = 7
+	moves = game.LegalActions("p0")
+	if len(moves) != 10 {
+		t.Errorf("LegalActions() with 7 coins length = %v, want 10", len(moves))
+	}
+
+	game.Players["p2"].IsAlive = false
+	game.Players["p0"].Coins = 10
+	moves = game.LegalActions("p0")
+	if len(moves) != 1 || moves[0].Action != Coup || moves[0].TargetID != "p1" {
+		t.Errorf("LegalActions() with 10 coins = %+v, want only Coup on p1", moves)
+	}
+}
+
+// TDD: Test legal responses during challenge and block phases
+func TestGame_LegalActions_Responses(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.PerformAction("p0", Steal, "p1")
+
+	if moves := game.LegalActions("p2"); countMoves(moves, MoveChallenge) != 1 || countMoves(moves, MovePass) != 1 {
+		t.Errorf("LegalActions() during challenge = %+v, want challenge and pass", moves)
+	}
+
+	if len(game.LegalActions("p0")) != 0 {
+		t.Error("LegalActions() should be empty for the actor during the challenge phase")
+	}
+
+	game.PassChallenge("p1")
+	game.PassChallenge("p2")
+
+	if len(game.LegalActions("p2")) != 0 {
+		t.Error("LegalActions() should be empty for players who cannot block Steal")
+	}
+
+	moves := game.LegalActions("p1")
+	if countMoves(moves, MoveBlock) != 2 || countMoves(moves, MovePass) != 1 {
+		t.Errorf("LegalActions() during block = %+v, want two blocks and pass", moves)
+	}
+}
+
+// TDD: Test legal card choices
+func TestGame_LegalActions_CardChoices(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p0"].Coins = 7
+	game.Players["p1"].Cards = []Card{Duke, Duke}
+	game.PerformAction("p0", Coup, "p1")
+
+	if moves := game.LegalActions("p1"); len(moves) != 1 || moves[0].Card != Duke {
+		t.Errorf("LegalActions() with duplicate cards = %+v, want a single Duke loss", moves)
+	}
+
+	game = newStartedGame(t, 3)
+	game.Players["p0"].Cards = []Card{Duke, Captain}
+	game.Deck = append([]Card{Duke, Contessa}, game.Deck...)
+	game.PerformAction("p0", Exchange, "")
+	passAll(t, game)
+
+	// Pool Duke, Captain, Duke, Contessa: {D,C} {D,D} {D,Co} {C,Co}
+	if moves := game.LegalActions("p0"); countMoves(moves, MoveExchange) != 4 {
+		t.Errorf("LegalActions() during exchange = %+v, want 4 distinct selections", moves)
+	}
+}
+
+// TDD: Test every legal move can be applied
+func TestGame_ApplyMove_LegalActions(t *testing.T) {
+	game := newStartedGame(t, 4)
+
+	for turn := 0; turn < 200 && game.State == Playing; turn++ {
+		decision := game.PendingDecision()
+		playerID := decision.PlayerIDs[0]
+		moves := game.LegalActions(playerID)
+		if len(moves) == 0 {
+			t.Fatalf("LegalActions(%s) is empty during %s", playerID, game.Phase)
+		}
+
+		move := moves[turn%len(moves)]
+		if err := game.ApplyMove(move); err != nil {
+			t.Fatalf("ApplyMove(%+v) error = %v", move, err)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package game
+
+import "fmt"
+
+// MoveKind identifies the kind of decision a move answers
+type MoveKind int
+
+const (
+	// MoveAction declares an action on the player's turn
+	MoveAction MoveKind = iota
+	// MoveChallenge challenges the claim currently on the table
+	MoveChallenge
+	// MovePass declines to challenge or block
+	MovePass
+	// MoveBlock blocks the pending action with a character claim
+	MoveBlock
+	// MoveLoseInfluence picks the card to reveal when losing an influence
+	MoveLoseInfluence
+	// MoveExchange picks the cards to keep after an exchange
+	MoveExchange
+	// MoveDraft picks the starting cards to keep in a two-player game
+	MoveDraft
+	// MoveShowCard picks the card an examined player shows the Inquisitor
+	MoveShowCard
+	// MoveExamine returns the examined card, or forces a swap when Swap is set
+	MoveExamine
+)
+
+// String returns the string representation of a move kind
+func (mk MoveKind) String() string {
+	switch mk {
+	case MoveAction:
+		return "action"
+	case MoveChallenge:
+		return "challenge"
+	case MovePass:
+		return "pass"
+	case MoveBlock:
+		return "block"
+	case MoveLoseInfluence:
+		return "lose_influence"
+	case MoveExchange:
+		return "exchange"
+	case MoveDraft:
+		return "draft"
+	case MoveShowCard:
+		return "show_card"
+	case MoveExamine:
+		return "examine"
+	default:
+		return "unknown"
+	}
+}
+
+// Move is a single decision a player can make, covering every kind of prompt in a turn
+type Move struct {
+	Kind     MoveKind   `json:"kind"`
+	PlayerID string     `json:"player_id"`
+	Action   ActionType `json:"action"`
+	TargetID string     `json:"target_id,omitempty"`
+	Card     Card       `json:"card"`
+	Cards    []Card     `json:"cards,omitempty"`
+	Swap     bool       `json:"swap,omitempty"`
+}
+
+// ApplyMove performs a move through the matching game method
+func (g *Game) ApplyMove(move Move) error {
+	switch move.Kind {
+	case MoveAction:
+		return g.PerformAction(move.PlayerID, move.Action, move.TargetID)
+	case MoveChallenge:
+		_, err := g.Challenge(move.PlayerID)
+		return err
+	case MovePass:
+		if g.Phase == PhaseBlock {
+			return g.PassBlock(move.PlayerID)
+		}
+		return g.PassChallenge(move.PlayerID)
+	case MoveBlock:
+		return g.Block(move.PlayerID, move.Card)
+	case MoveLoseInfluence:
+		return g.ChooseInfluenceLoss(move.PlayerID, move.Card)
+	case MoveExchange:
+		return g.ChooseExchangeCards(move.PlayerID, move.Cards)
+	case MoveDraft:
+		return g.ChooseStartingCards(move.PlayerID, move.Cards)
+	case MoveShowCard:
+		return g.ShowCard(move.PlayerID, move.Card)
+	case MoveExamine:
+		return g.ChooseExamineSwap(move.PlayerID, move.Swap)
+	default:
+		return fmt.Errorf("unknown move kind: %d", move.Kind)
+	}
+}
@@ -0,0 +1,32 @@
+package game
+
+// Option configures a game when it is created
+type Option func(*Game)
+
+// WithSeed makes every shuffle in the game derive from the given seed
+func WithSeed(seed int64) Option {
+	return func(g *Game) {
+		g.rng = NewRandom(seed)
+	}
+}
+
+// WithRandom makes the game draw its randomness from the given generator
+func WithRandom(rng *Random) Option {
+	return func(g *Game) {
+		g.rng = rng
+	}
+}
+
+// WithRuleSet makes the game play by the given rules; they are validated when the game starts
+func WithRuleSet(rules RuleSet) Option {
+	return func(g *Game) {
+		g.applyRuleSet(rules)
+	}
+}
+
+// WithClock makes the game read the current time from the given clock
+func WithClock(clock Clock) Option {
+	return func(g *Game) {
+		g.clock = clock
+	}
+}
@@ -0,0 +1,111 @@
+package game
+
+import "fmt"
+
+// TurnPhase represents the step of the current turn the game is waiting on
+type TurnPhase int
+
+const (
+	// PhaseNone means no turn is in progress (game not started or finished)
+	PhaseNone TurnPhase = iota
+	// PhaseAction waits for the current player to choose an action
+	PhaseAction
+	// PhaseChallenge waits for the other players to challenge or accept the actor's claim
+	PhaseChallenge
+	// PhaseBlock waits for eligible players to block or allow the action
+	PhaseBlock
+	// PhaseBlockChallenge waits for the actor to challenge or accept the block
+	PhaseBlockChallenge
+	// PhaseInfluenceLoss waits for a player to choose which card to reveal
+	PhaseInfluenceLoss
+	// PhaseExchange waits for the exchanging player to choose which cards to keep
+	PhaseExchange
+	// PhaseDraft waits for the players of a two-player game to pick their starting hands
+	PhaseDraft
+	// PhaseShowCard waits for an examined player to choose which card to show the Inquisitor
+	PhaseShowCard
+	// PhaseExamine waits for the Inquisitor to return the examined card or force a swap
+	PhaseExamine
+)
+
+// phaseTransitions lists the phases each phase may move on to; every phase may also
+// move to PhaseNone when the game ends
+var phaseTransitions = map[TurnPhase][]TurnPhase{
+	PhaseNone:           {PhaseAction, PhaseDraft},
+	PhaseDraft:          {PhaseDraft, PhaseAction},
+	PhaseAction:         {PhaseAction, PhaseChallenge, PhaseBlock, PhaseInfluenceLoss},
+	PhaseChallenge:      {PhaseAction, PhaseBlock, PhaseInfluenceLoss, PhaseExchange, PhaseShowCard, PhaseExamine},
+	PhaseBlock:          {PhaseAction, PhaseBlockChallenge, PhaseInfluenceLoss},
+	PhaseBlockChallenge: {PhaseAction, PhaseInfluenceLoss},
+	PhaseInfluenceLoss:  {PhaseAction, PhaseBlock, PhaseInfluenceLoss, PhaseExchange, PhaseShowCard, PhaseExamine},
+	PhaseExchange:       {PhaseAction},
+	PhaseShowCard:       {PhaseExamine},
+	PhaseExamine:        {PhaseAction},
+}
+
+// String returns the string representation of a turn phase
+func (tp TurnPhase) String() string {
+	switch tp {
+	case PhaseNone:
+		return "None"
+	case PhaseAction:
+		return "Action"
+	case PhaseChallenge:
+		return "Challenge"
+	case PhaseBlock:
+		return "Block"
+	case PhaseBlockChallenge:
+		return "BlockChallenge"
+	case PhaseInfluenceLoss:
+		return "InfluenceLoss"
+	case PhaseExchange:
+		return "Exchange"
+	case PhaseDraft:
+		return "Draft"
+	case PhaseShowCard:
+		return "ShowCard"
+	case PhaseExamine:
+		return "Examine"
+	default:
+		return "Unknown"
+	}
+}
+
+// CanTransitionTo reports whether the turn may move from this phase to the next one
+func (tp TurnPhase) CanTransitionTo(next TurnPhase) bool {
+	if next == PhaseNone {
+		return true
+	}
+
+	for _, allowed := range phaseTransitions[tp] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// transition moves the turn to the next phase, rejecting sequences the rules do not allow
+func (g *Game) transition(next TurnPhase) error {
+	if !g.Phase.CanTransitionTo(next) {
+		return fmt.Errorf("illegal turn phase transition from %s to %s", g.Phase, next)
+	}
+
+	g.Phase = next
+	g.phaseStarted = g.clock.Now()
+	return nil
+}
+
+// requirePhase checks that an operation is allowed in the current turn phase
+func (g *Game) requirePhase(operation string, phases ...TurnPhase) error {
+	if g.State != Playing {
+		return fmt.Errorf("cannot %s: game is not in playing state", operation)
+	}
+
+	for _, phase := range phases {
+		if g.Phase == phase {
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot %s during the %s phase", operation, g.Phase)
+}
@@ -0,0 +1,134 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+// TDD: Test turn phase string representation
+func TestTurnPhase_String(t *testing.T) {
+	tests := []struct {
+		phase    TurnPhase
+		expected string
+	}{
+		{PhaseNone, "None"},
+		{PhaseAction, "Action"},
+		{PhaseChallenge, "Challenge"},
+		{PhaseBlock, "Block"},
+		{PhaseBlockChallenge, "BlockChallenge"},
+		{PhaseInfluenceLoss, "InfluenceLoss"},
+		{PhaseExchange, "Exchange"},
+		{TurnPhase(99), "Unknown"},
+	}
+
+	for _, test := range tests {
+		if result := test.phase.String(); result != test.expected {
+			t.Errorf("TurnPhase.String() = %v, want %v", result, test.expected)
+		}
+	}
+}
+
+// TDD: Test allowed and rejected phase transitions
+func TestTurnPhase_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from     TurnPhase
+		to       TurnPhase
+		expected bool
+	}{
+		{PhaseNone, PhaseAction, true},
+		{PhaseNone, PhaseChallenge, false},
+		{PhaseAction, PhaseChallenge, true},
+		{PhaseAction, PhaseBlockChallenge, false},
+		{PhaseAction, PhaseExchange, false},
+		{PhaseChallenge, PhaseBlock, true},
+		{PhaseChallenge, PhaseBlockChallenge, false},
+		{PhaseBlock, PhaseBlockChallenge, true},
+		{PhaseBlock, PhaseChallenge, false},
+		{PhaseBlockChallenge, PhaseBlock, false},
+		{PhaseInfluenceLoss, PhaseInfluenceLoss, true},
+		{PhaseExchange, PhaseAction, true},
+		{PhaseExchange, PhaseInfluenceLoss, false},
+		{PhaseExchange, PhaseNone, true},
+	}
+
+	for _, test := range tests {
+		if result := test.from.CanTransitionTo(test.to); result != test.expected {
+			t.Errorf("%v.CanTransitionTo(%v) = %v, want %v", test.from, test.to, result, test.expected)
+		}
+	}
+}
+
+// TDD: Test illegal transitions are rejected with a clear error
+func TestGame_Transition_Illegal(t *testing.T) {
+	game := newStartedGame(t, 3)
+
+	err := game.transition(PhaseBlockChallenge)
+	if err == nil {
+		t.Fatal("transition() should reject Action -> BlockChallenge")
+	}
+
+	if !strings.Contains(err.Error(), "Action") || !strings.Contains(err.Error(), "BlockChallenge") {
+		t.Errorf("transition() error = %v, want both phases named", err)
+	}
+
+	if game.Phase != PhaseAction {
+		t.Errorf("Phase = %v, want Action after a rejected transition", game.Phase)
+	}
+}
+
+// TDD: Test the game phase follows the turn
+func TestGame_Phase(t *testing.T) {
+	game := NewGame("test")
+	if game.Phase != PhaseNone {
+		t.Errorf("Phase before start = %v, want None", game.Phase)
+	}
+
+	game = newStartedGame(t, 3)
+	if game.Phase != PhaseAction {
+		t.Errorf("Phase after start = %v, want Action", game.Phase)
+	}
+
+	game.PerformAction("p0", Income, "")
+	if game.Phase != PhaseAction {
+		t.Errorf("Phase after Income = %v, want Action", game.Phase)
+	}
+
+	if err := game.Block("p2", Duke); err == nil || !strings.Contains(err.Error(), "Action phase") {
+		t.Errorf("Block() after Income error = %v, want rejection during the Action phase", err)
+	}
+
+	game.PerformAction("p1", Tax, "")
+	if game.Phase != PhaseChallenge {
+		t.Errorf("Phase after Tax = %v, want Challenge", game.Phase)
+	}
+
+	if err := game.PassBlock("p2"); err == nil {
+		t.Error("PassBlock() should return error during the Challenge phase")
+	}
+
+	if err := game.ChooseInfluenceLoss("p2", Duke); err == nil {
+		t.Error("ChooseInfluenceLoss() should return error during the Challenge phase")
+	}
+
+	if err := game.ChooseExchangeCards("p1", nil); err == nil {
+		t.Error("ChooseExchangeCards() should return error during the Challenge phase")
+	}
+
+	if state := game.GetGameState(); state["phase"] != "Challenge" {
+		t.Errorf("Game state phase = %v, want Challenge", state["phase"])
+	}
+}
+
+// TDD: Test the phase resets when the game ends
+func TestGame_Phase_GameOver(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p2"].IsAlive = false
+	game.Players["p1"].Cards = game.Players["p1"].Cards[:1]
+	game.Players["p0"].Coins = 7
+
+	game.PerformAction("p0", Coup, "p1")
+
+	if game.Phase != PhaseNone {
+		t.Errorf("Phase after game over = %v, want None", game.Phase)
+	}
+}
@@ -6,31 +6,55 @@ import (
 	"time"
 )
 
+const (
+	// DefaultHandSize is the number of influence cards each player is dealt
+	DefaultHandSize = 2
+	// MaxHandSize is the largest hand a rule set may deal
+	MaxHandSize = 4
+)
+
 // Player represents a player in the Coup game
 type Player struct {
-	ID       string `json:"id"`
-	Name     string `json:"name"`
-	Coins    int    `json:"coins"`
-	Cards    []Card `json:"-"`
-	IsAlive  bool   `json:"is_alive"`
-	IsActive bool   `json:"is_active"`
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	Coins         int     `json:"coins"`
+	Cards         []Card  `json:"-"`
+	RevealedCards []Card  `json:"revealed_cards"`
+	IsAlive       bool    `json:"is_alive"`
+	IsActive      bool    `json:"is_active"`
+	Faction       Faction `json:"faction"`
+	// TimeBank is the time left on the player's chess clock, as of the last move or tick
+	TimeBank time.Duration `json:"time_bank,omitempty"`
+
+	handSize    int
+	hasTimeBank bool
 }
 
 // NewPlayer creates a new player with starting conditions
 func NewPlayer(id, name string) *Player {
 	return &Player{
-		ID:       id,
-		Name:     name,
-		Coins:    2,
-		Cards:    make([]Card, 0, 2),
-		IsAlive:  true,
-		IsActive: true,
+		ID:            id,
+		Name:          name,
+		Coins:         StartingCoins,
+		Cards:         make([]Card, 0, 2),
+		RevealedCards: make([]Card, 0, 2),
+		IsAlive:       true,
+		IsActive:      true,
+		handSize:      DefaultHandSize,
 	}
 }
 
+// Clone returns a deep copy of the player
+func (p *Player) Clone() *Player {
+	clone := *p
+	clone.Cards = cloneCards(p.Cards)
+	clone.RevealedCards = cloneCards(p.RevealedCards)
+	return &clone
+}
+
 // AddCard adds a card to the player's hand
 func (p *Player) AddCard(card Card) error {
-	if len(p.Cards) >= 2 {
+	if len(p.Cards) >= p.handSize {
 		return fmt.Errorf("player already has maximum cards")
 	}
 	p.Cards = append(p.Cards, card)
@@ -55,6 +79,16 @@ func (p *Player) RemoveCard(card Card) error {
 	return fmt.Errorf("player does not have card: %s", card.String())
 }
 
+// RevealCard turns one of the player's cards face up after losing an influence
+func (p *Player) RevealCard(card Card) error {
+	if err := p.RemoveCard(card); err != nil {
+		return err
+	}
+
+	p.RevealedCards = append(p.RevealedCards, card)
+	return nil
+}
+
 // HasCard checks if the player has a specific card
 func (p *Player) HasCard(card Card) bool {
 	for _, c := range p.Cards {
@@ -65,7 +99,7 @@ func (p *Player) HasCard(card Card) bool {
 	return false
 }
 
-// CanAfford checks if the player can afford an action
+// CanAfford checks if the player can afford an action at its standard cost
 func (p *Player) CanAfford(action ActionType) bool {
 	return p.Coins >= action.GetCost()
 }
@@ -87,51 +121,56 @@ func (p *Player) RemoveCoins(amount int) error {
 	return nil
 }
 
-// MustCoup returns true if player must coup (has 10+ coins)
+// MustCoup returns true if player must coup under the standard rules (has 10+ coins)
 func (p *Player) MustCoup() bool {
 	return p.Coins >= 10
 }
 
 // GetPublicInfo returns player information visible to other players
 func (p *Player) GetPublicInfo() map[string]interface{} {
-	return map[string]interface{}{
-		"id":         p.ID,
-		"name":       p.Name,
-		"coins":      p.Coins,
-		"card_count": len(p.Cards),
-		"is_alive":   p.IsAlive,
-		"is_active":  p.IsActive,
+	info := map[string]interface{}{
+		"id":             p.ID,
+		"name":           p.Name,
+		"coins":          p.Coins,
+		"card_count":     len(p.Cards),
+		"revealed_cards": cardNames(p.RevealedCards),
+		"is_alive":       p.IsAlive,
+		"is_active":      p.IsActive,
 	}
+
+	if p.Faction != NoFaction {
+		info["faction"] = p.Faction.String()
+	}
+
+	if p.hasTimeBank {
+		info["time_bank_ms"] = p.TimeBank.Milliseconds()
+	}
+
+	return info
 }
 
 // GetPrivateInfo returns all player information (for the player themselves)
 func (p *Player) GetPrivateInfo() map[string]interface{} {
-	cardNames := make([]string, len(p.Cards))
-	for i, card := range p.Cards {
-		cardNames[i] = card.String()
-	}
-
 	info := p.GetPublicInfo()
-	info["cards"] = cardNames
+	info["cards"] = cardNames(p.Cards)
 	return info
 }
 
-// ShuffleCards shuffles a slice of cards
+// ShuffleCards shuffles a slice of cards with the shared math/rand source;
+// games shuffle with their own seeded Random instead
 func ShuffleCards(cards []Card) {
-	rand.Seed(time.Now().UnixNano())
 	rand.Shuffle(len(cards), func(i, j int) {
 		cards[i], cards[j] = cards[j], cards[i]
 	})
 }
 
-// DealCards deals 2 cards to a player from the deck
+// DealCards deals a full hand to a player from the deck
 func DealCards(player *Player, deck *[]Card) error {
-	if len(*deck) < 2 {
+	if len(*deck) < player.handSize {
 		return fmt.Errorf("insufficient cards in deck")
 	}
 
-	// Deal 2 cards
-	for i := 0; i < 2; i++ {
+	for i := 0; i < player.handSize; i++ {
 		card := (*deck)[0]
 		*deck = (*deck)[1:]
 
@@ -203,3 +203,50 @@ func TestPlayer_GetInfo(t *testing.T) {
 		t.Errorf("GetPrivateInfo() cards length = %v, want 2", len(cardSlice))
 	}
 }
+
+// TDD: Test revealing a lost influence
+func TestPlayer_RevealCard(t *testing.T) {
+	player := NewPlayer("test", "Test")
+	player.AddCard(Duke)
+	player.AddCard(Contessa)
+
+	if err := player.RevealCard(Captain); err == nil {
+		t.Error("RevealCard() should return error for a card the player does not hold")
+	}
+
+	if err := player.RevealCard(Duke); err != nil {
+		t.Errorf("RevealCard() error = %v, want nil", err)
+	}
+
+	if len(player.RevealedCards) != 1 || player.RevealedCards[0] != Duke {
+		t.Errorf("RevealedCards = %v, want [Duke]", player.RevealedCards)
+	}
+
+	info := player.GetPublicInfo()
+	if revealed, ok := info["revealed_cards"].([]string); !ok || len(revealed) != 1 || revealed[0] != "Duke" {
+		t.Errorf("GetPublicInfo() revealed_cards = %v, want [Duke]", info["revealed_cards"])
+	}
+
+	player.RevealCard(Contessa)
+	if player.IsAlive {
+		t.Error("Player should be eliminated after revealing the last card")
+	}
+}
+
+// TDD: Test cloning a player copies their cards
+func TestPlayer_Clone(t *testing.T) {
+	player := NewPlayer("1", "Test Player")
+	player.AddCard(Duke)
+	player.AddCard(Captain)
+
+	clone := player.Clone()
+	clone.RevealCard(Duke)
+
+	if len(player.Cards) != 2 || len(player.RevealedCards) != 0 {
+		t.Errorf("Original after clone reveal: cards = %v, revealed = %v", player.Cards, player.RevealedCards)
+	}
+
+	if len(clone.Cards) != 1 || clone.Cards[0] != Captain {
+		t.Errorf("Clone cards = %v, want [Captain]", clone.Cards)
+	}
+}
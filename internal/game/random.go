@@ -0,0 +1,108 @@
+package game
+
+import (
+	"sync"
+	"time"
+)
+
+// Random is a seeded SplitMix64 pseudo-random generator. Its output depends only on the
+// seed, so a game created with the same seed shuffles identically on every run and Go release.
+type Random struct {
+	seed  int64
+	state uint64
+}
+
+// NewRandom creates a generator starting from the given seed
+func NewRandom(seed int64) *Random {
+	return &Random{
+		seed:  seed,
+		state: uint64(seed),
+	}
+}
+
+// Seed returns the seed the generator was created with
+func (r *Random) Seed() int64 {
+	return r.seed
+}
+
+// Clone returns an independent generator that continues from the same state
+func (r *Random) Clone() *Random {
+	clone := *r
+	return &clone
+}
+
+// Uint64 returns the next pseudo-random 64-bit value
+func (r *Random) Uint64() uint64 {
+	r.state += 0x9e3779b97f4a7c15
+	z := r.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// Intn returns a pseudo-random number in [0, n)
+func (r *Random) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	return int(r.Uint64() % uint64(n))
+}
+
+// Shuffle pseudo-randomizes the order of n elements using the Fisher-Yates algorithm
+func (r *Random) Shuffle(n int, swap func(i, j int)) {
+	for i := n - 1; i > 0; i-- {
+		swap(i, r.Intn(i+1))
+	}
+}
+
+// ShuffleCards shuffles a slice of cards in place
+func (r *Random) ShuffleCards(cards []Card) {
+	r.Shuffle(len(cards), func(i, j int) {
+		cards[i], cards[j] = cards[j], cards[i]
+	})
+}
+
+// Clock is the source of the current time for a game
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock reads the time from the operating system
+type SystemClock struct{}
+
+// Now returns the current system time
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// FakeClock is a manually controlled clock for simulations and tests
+type FakeClock struct {
+	now time.Time
+	mu  sync.Mutex
+}
+
+// NewFakeClock creates a clock frozen at the given time
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+// Now returns the clock's current time
+func (fc *FakeClock) Now() time.Time {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return fc.now
+}
+
+// Advance moves the clock forward by the given duration
+func (fc *FakeClock) Advance(d time.Duration) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.now = fc.now.Add(d)
+}
+
+// Set moves the clock to the given time
+func (fc *FakeClock) Set(t time.Time) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.now = t
+}
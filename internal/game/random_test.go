@@ -0,0 +1,113 @@
+package game
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TDD: Test the generator is reproducible from its seed
+func TestRandom_Deterministic(t *testing.T) {
+	first := NewRandom(7)
+	second := NewRandom(7)
+
+	for i := 0; i < 100; i++ {
+		if a, b := first.Intn(1000), second.Intn(1000); a != b {
+			t.Fatalf("Intn() call %d = %v and %v, want equal for the same seed", i, a, b)
+		}
+	}
+
+	if first.Seed() != 7 {
+		t.Errorf("Seed() = %v, want 7", first.Seed())
+	}
+
+	if NewRandom(7).Uint64() == NewRandom(8).Uint64() {
+		t.Error("Uint64() should differ for different seeds")
+	}
+}
+
+// TDD: Test shuffling keeps every card
+func TestRandom_ShuffleCards(t *testing.T) {
+	cards := GetAllCards()
+	NewRandom(1).ShuffleCards(cards)
+
+	counts := make(map[Card]int)
+	for _, card := range cards {
+		counts[card]++
+	}
+
+	for _, card := range []Card{Duke, Assassin, Ambassador, Captain, Contessa} {
+		if counts[card] != 3 {
+			t.Errorf("Shuffled deck has %d %v, want 3", counts[card], card)
+		}
+	}
+}
+
+// TDD: Test the fake clock only moves when told to
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	if !clock.Now().Equal(start) {
+		t.Errorf("Now() = %v, want %v", clock.Now(), start)
+	}
+
+	clock.Advance(time.Minute)
+	if !clock.Now().Equal(start.Add(time.Minute)) {
+		t.Errorf("Now() after Advance() = %v, want %v", clock.Now(), start.Add(time.Minute))
+	}
+
+	clock.Set(start)
+	if !clock.Now().Equal(start) {
+		t.Errorf("Now() after Set() = %v, want %v", clock.Now(), start)
+	}
+}
+
+// playSeededGame plays a game from a seed with a fixed move policy and returns it
+func playSeededGame(t *testing.T, seed int64) *Game {
+	t.Helper()
+
+	clock := NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	game := NewGame("sim", WithSeed(seed), WithClock(clock))
+	for i := 0; i < 4; i++ {
+		game.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i)))
+	}
+	if err := game.StartGame(); err != nil {
+		t.Fatalf("StartGame() error = %v", err)
+	}
+
+	for turn := 0; turn < 300 && game.State == Playing; turn++ {
+		clock.Advance(time.Second)
+		moves := game.LegalActions(game.PendingDecision().PlayerIDs[0])
+		if err := game.ApplyMove(moves[(turn*5)%len(moves)]); err != nil {
+			t.Fatalf("ApplyMove() error = %v", err)
+		}
+	}
+
+	return game
+}
+
+// TDD: Test a seeded game with a fake clock replays identically
+func TestGame_Deterministic(t *testing.T) {
+	first := playSeededGame(t, 99)
+	second := playSeededGame(t, 99)
+
+	if !reflect.DeepEqual(first.GetGameState(), second.GetGameState()) {
+		t.Error("Games with the same seed and clock should end in the same state")
+	}
+
+	for id, player := range first.Players {
+		if !reflect.DeepEqual(player.Cards, second.Players[id].Cards) {
+			t.Errorf("Player %s cards = %v and %v, want equal", id, player.Cards, second.Players[id].Cards)
+		}
+	}
+
+	if !reflect.DeepEqual(first.Deck, second.Deck) {
+		t.Error("Decks should be identical for the same seed")
+	}
+
+	if first.Seed() != 99 {
+		t.Errorf("Seed() = %v, want 99", first.Seed())
+	}
+}
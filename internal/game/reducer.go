@@ -0,0 +1,103 @@
+package game
+
+// Clone returns a deep copy of the game. The copy shares no mutable state with the
+// original: players, deck, pending decisions and the random generator are all copied,
+// so moves applied to one never affect the other. The clock is shared.
+func (g *Game) Clone() *Game {
+	clone := *g
+	clone.Rules.Deck = cloneCards(g.Rules.Deck)
+
+	clone.Players = make(map[string]*Player, len(g.Players))
+	for id, player := range g.Players {
+		clone.Players[id] = player.Clone()
+	}
+	if g.Winner != nil {
+		clone.Winner = clone.Players[g.Winner.ID]
+	}
+
+	clone.timePenalties = append([]string(nil), g.timePenalties...)
+
+	clone.PlayerOrder = append(make([]string, 0, len(g.PlayerOrder)), g.PlayerOrder...)
+	clone.Deck = cloneCards(g.Deck)
+	clone.DiscardPile = cloneCards(g.DiscardPile)
+
+	if g.Treasury != nil {
+		treasury := *g.Treasury
+		clone.Treasury = &treasury
+	}
+
+	if g.StartsAt != nil {
+		startsAt := *g.StartsAt
+		clone.StartsAt = &startsAt
+	}
+	if g.StartedAt != nil {
+		startedAt := *g.StartedAt
+		clone.StartedAt = &startedAt
+	}
+	if g.FinishedAt != nil {
+		finishedAt := *g.FinishedAt
+		clone.FinishedAt = &finishedAt
+	}
+
+	if g.PendingAction != nil {
+		pending := *g.PendingAction
+		pending.Passed = make(map[string]bool, len(g.PendingAction.Passed))
+		for id, passed := range g.PendingAction.Passed {
+			pending.Passed[id] = passed
+		}
+		clone.PendingAction = &pending
+	}
+
+	if g.InfluenceLoss != nil {
+		loss := *g.InfluenceLoss
+		clone.InfluenceLoss = &loss
+	}
+
+	if g.PendingExchange != nil {
+		exchange := *g.PendingExchange
+		exchange.Cards = cloneCards(g.PendingExchange.Cards)
+		clone.PendingExchange = &exchange
+	}
+
+	if g.PendingDraft != nil {
+		draft := *g.PendingDraft
+		draft.Pools = make(map[string][]Card, len(g.PendingDraft.Pools))
+		for id, pool := range g.PendingDraft.Pools {
+			draft.Pools[id] = cloneCards(pool)
+		}
+		clone.PendingDraft = &draft
+	}
+
+	if g.Examination != nil {
+		examination := *g.Examination
+		clone.Examination = &examination
+	}
+
+	if g.rng != nil {
+		clone.rng = g.rng.Clone()
+	}
+	if g.timeoutRng != nil {
+		clone.timeoutRng = g.timeoutRng.Clone()
+	}
+
+	// Logged events are never modified, so the copy shares them; capping the capacity
+	// makes the first event the copy appends reallocate instead of writing into ours
+	clone.log = g.log[:len(g.log):len(g.log)]
+
+	return &clone
+}
+
+// Apply is the pure form of ApplyMove: it plays a move on a copy of the snapshot and
+// returns the resulting game with the events the move produced. The snapshot itself is
+// never modified, so callers can explore many lines of play from the same position.
+func Apply(snapshot *Game, move Move) (*Game, []Event, error) {
+	next := snapshot.Clone()
+	if err := next.ApplyMove(move); err != nil {
+		return nil, nil, err
+	}
+
+	events := make([]Event, len(next.log)-len(snapshot.log))
+	copy(events, next.log[len(snapshot.log):])
+
+	return next, events, nil
+}
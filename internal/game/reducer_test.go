@@ -0,0 +1,95 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TDD: Test a clone shares no mutable state with the original
+func TestGame_Clone(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.PerformAction("p0", Tax, "")
+
+	clone := game.Clone()
+	if !reflect.DeepEqual(clone.GetGameState(), game.GetGameState()) {
+		t.Fatal("Clone() should start in the same state")
+	}
+
+	clone.Players["p0"].Cards[0] = Contessa
+	clone.Players["p0"].Coins = 9
+	clone.Deck[0] = Duke
+	clone.PendingAction.Passed["p2"] = true
+	clone.Treasury.Coins = 0
+
+	if game.Players["p0"].Coins == 9 || game.Treasury.Coins == 0 || game.PendingAction.Passed["p2"] {
+		t.Error("Changing the clone should not change the original")
+	}
+
+	if err := clone.PassChallenge("p1"); err != nil {
+		t.Fatalf("PassChallenge() on clone error = %v", err)
+	}
+	if len(game.Events()) == len(clone.Events()) {
+		t.Error("Events logged on the clone should not appear in the original")
+	}
+
+	// Both copies must draw the same shuffles from here on
+	if game.rng.Uint64() != clone.rng.Uint64() {
+		t.Error("Clone() should copy the random generator state")
+	}
+}
+
+// TDD: Test Apply leaves the snapshot untouched and returns the new events
+func TestApply(t *testing.T) {
+	snapshot := newStartedGame(t, 3)
+	before := snapshot.GetGameState()
+	beforeEvents := snapshot.Events()
+
+	next, events, err := Apply(snapshot, Move{Kind: MoveAction, PlayerID: "p0", Action: Income})
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(snapshot.GetGameState(), before) || !reflect.DeepEqual(snapshot.Events(), beforeEvents) {
+		t.Error("Apply() should not modify the snapshot")
+	}
+
+	if next.Players["p0"].Coins != 3 || next.GetCurrentPlayer().ID != "p1" {
+		t.Errorf("Apply() next state coins = %v, current = %v, want 3 and p1", next.Players["p0"].Coins, next.GetCurrentPlayer().ID)
+	}
+
+	want := []EventType{EventActionDeclared, EventCoinsMoved, EventTurnStarted}
+	if got := eventTypes(events); !reflect.DeepEqual(got, want) {
+		t.Errorf("Apply() events = %v, want %v", got, want)
+	}
+}
+
+// TDD: Test an illegal move returns an error and no game
+func TestApply_Illegal(t *testing.T) {
+	snapshot := newStartedGame(t, 3)
+
+	next, events, err := Apply(snapshot, Move{Kind: MoveAction, PlayerID: "p1", Action: Income})
+	if err == nil || next != nil || events != nil {
+		t.Errorf("Apply() out of turn = %v, %v, %v, want an error only", next, events, err)
+	}
+
+	if snapshot.Phase != PhaseAction || snapshot.Players["p1"].Coins != 2 {
+		t.Error("A failed Apply() should not modify the snapshot")
+	}
+}
+
+// TDD: Test every legal move can be explored from the same snapshot
+func TestApply_Explore(t *testing.T) {
+	snapshot := newStartedGame(t, 4)
+	snapshot.Players["p0"].Coins = 7
+	before := snapshot.GetPlayerGameState("p0")
+
+	for _, move := range snapshot.LegalActions("p0") {
+		if _, _, err := Apply(snapshot, move); err != nil {
+			t.Errorf("Apply(%+v) error = %v", move, err)
+		}
+	}
+
+	if !reflect.DeepEqual(snapshot.GetPlayerGameState("p0"), before) {
+		t.Error("Exploring moves should not modify the snapshot")
+	}
+}
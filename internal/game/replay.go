@@ -0,0 +1,128 @@
+package game
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Rebuild recreates a game by replaying its event log. The commands in the log are
+// re-applied on a game with the same seed, and every resulting event must match the
+// log exactly. The rebuilt game reads time from clock afterwards (the system clock if nil).
+func Rebuild(events []Event, clock Clock) (*Game, error) {
+	if len(events) == 0 || events[0].Type != EventGameCreated {
+		return nil, fmt.Errorf("event log must start with %s", EventGameCreated)
+	}
+
+	created := events[0]
+	replayClock := NewFakeClock(created.At)
+	opts := []Option{WithSeed(created.Seed), WithClock(replayClock)}
+	if created.Rules != nil {
+		opts = append(opts, WithRuleSet(*created.Rules))
+	}
+	g := NewGame(created.GameID, opts...)
+
+	for _, event := range events[1:] {
+		if !isCommand(event.Type) {
+			continue
+		}
+
+		replayClock.Set(event.At)
+		g.timingOut = event.TimedOut
+		err := g.applyCommand(event)
+		g.timingOut = false
+		if err != nil {
+			return nil, fmt.Errorf("replaying event %d (%s): %w", event.Seq, event.Type, err)
+		}
+	}
+
+	if err := compareLogs(g.log, events); err != nil {
+		return nil, err
+	}
+
+	if clock == nil {
+		clock = SystemClock{}
+	}
+	g.clock = clock
+
+	return g, nil
+}
+
+// isCommand reports whether an event records a decision rather than its consequences
+func isCommand(eventType EventType) bool {
+	switch eventType {
+	case EventTreasurySized, EventRulesSet, EventPlayerJoined, EventPlayerLeft, EventCountdownBegun,
+		EventGameStarted, EventActionDeclared, EventChallenged, EventPassed, EventBlockDeclared,
+		EventInfluenceChosen, EventExchangeChosen, EventDraftChosen, EventShowChosen, EventExamined,
+		EventTimeExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+// applyCommand re-applies a command event to the game
+func (g *Game) applyCommand(event Event) error {
+	switch event.Type {
+	case EventTreasurySized:
+		return g.SetTreasurySize(event.Amount)
+	case EventRulesSet:
+		if event.Rules == nil {
+			return fmt.Errorf("%s event has no rules", event.Type)
+		}
+		return g.SetRuleSet(*event.Rules)
+	case EventPlayerJoined:
+		return g.AddPlayer(NewPlayer(event.PlayerID, event.Name))
+	case EventPlayerLeft:
+		return g.RemovePlayer(event.PlayerID)
+	case EventCountdownBegun:
+		if event.StartsAt == nil {
+			return fmt.Errorf("%s event has no start time", event.Type)
+		}
+		return g.BeginCountdown(*event.StartsAt)
+	case EventGameStarted:
+		return g.StartGame()
+	case EventActionDeclared:
+		return g.ApplyMove(Move{Kind: MoveAction, PlayerID: event.PlayerID, Action: event.Action, TargetID: event.TargetID})
+	case EventChallenged:
+		return g.ApplyMove(Move{Kind: MoveChallenge, PlayerID: event.PlayerID})
+	case EventPassed:
+		return g.ApplyMove(Move{Kind: MovePass, PlayerID: event.PlayerID})
+	case EventBlockDeclared:
+		return g.ApplyMove(Move{Kind: MoveBlock, PlayerID: event.PlayerID, Card: event.Card})
+	case EventInfluenceChosen:
+		return g.ApplyMove(Move{Kind: MoveLoseInfluence, PlayerID: event.PlayerID, Card: event.Card})
+	case EventExchangeChosen:
+		return g.ApplyMove(Move{Kind: MoveExchange, PlayerID: event.PlayerID, Cards: event.Cards})
+	case EventDraftChosen:
+		return g.ApplyMove(Move{Kind: MoveDraft, PlayerID: event.PlayerID, Cards: event.Cards})
+	case EventShowChosen:
+		if len(event.Cards) != 1 {
+			return fmt.Errorf("%s event must hold one card", event.Type)
+		}
+		return g.ApplyMove(Move{Kind: MoveShowCard, PlayerID: event.PlayerID, Card: event.Cards[0]})
+	case EventExamined:
+		return g.ApplyMove(Move{Kind: MoveExamine, PlayerID: event.PlayerID, Swap: event.Successful})
+	case EventTimeExpired:
+		return g.expireTimeBank(event.PlayerID)
+	default:
+		return fmt.Errorf("unknown command: %s", event.Type)
+	}
+}
+
+// compareLogs checks that a replayed log reproduces the original one. Timestamps are not
+// compared: consequences are replayed at the instant of the command that caused them.
+func compareLogs(replayed, original []Event) error {
+	if len(replayed) != len(original) {
+		return fmt.Errorf("replay produced %d events, log has %d", len(replayed), len(original))
+	}
+
+	for i := range original {
+		got, want := replayed[i], original[i]
+		got.At = want.At
+		if !reflect.DeepEqual(got, want) {
+			return fmt.Errorf("replay diverged at event %d: got %s, log has %s", original[i].Seq, replayed[i].Type, original[i].Type)
+		}
+	}
+
+	return nil
+}
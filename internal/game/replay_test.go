@@ -0,0 +1,65 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TDD: Test a played game is rebuilt identically from its log
+func TestRebuild(t *testing.T) {
+	original := playSeededGame(t, 42)
+
+	rebuilt, err := Rebuild(original.Events(), nil)
+	if err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(rebuilt.Events(), original.Events()) {
+		t.Error("Rebuilt game should have the same event log")
+	}
+
+	if !reflect.DeepEqual(rebuilt.GetGameState(), original.GetGameState()) {
+		t.Error("Rebuilt game should end in the same state")
+	}
+
+	for id, player := range original.Players {
+		if !reflect.DeepEqual(player.Cards, rebuilt.Players[id].Cards) {
+			t.Errorf("Player %s cards = %v, want %v", id, rebuilt.Players[id].Cards, player.Cards)
+		}
+	}
+
+	if !reflect.DeepEqual(rebuilt.Deck, original.Deck) {
+		t.Error("Rebuilt deck should match the original")
+	}
+}
+
+// TDD: Test a log must start with the game's creation
+func TestRebuild_InvalidLog(t *testing.T) {
+	if _, err := Rebuild(nil, nil); err == nil {
+		t.Error("Rebuild() of an empty log should fail")
+	}
+
+	events := playSeededGame(t, 1).Events()
+	if _, err := Rebuild(events[1:], nil); err == nil {
+		t.Error("Rebuild() without game_created should fail")
+	}
+}
+
+// TDD: Test a tampered log is detected
+func TestRebuild_Diverged(t *testing.T) {
+	events := playSeededGame(t, 7).Events()
+
+	for i, event := range events {
+		if event.Type == EventCardsDealt {
+			events[i].Cards = []Card{Duke, Duke}
+			if reflect.DeepEqual(event.Cards, events[i].Cards) {
+				events[i].Cards = []Card{Contessa, Contessa}
+			}
+			break
+		}
+	}
+
+	if _, err := Rebuild(events, nil); err == nil {
+		t.Error("Rebuild() of a tampered log should fail")
+	}
+}
@@ -0,0 +1,271 @@
+package game
+
+import "fmt"
+
+// RuleSet holds the numbers and house rules a game is played with
+type RuleSet struct {
+	StartingCoins       int `json:"starting_coins"`
+	CoupCost            int `json:"coup_cost"`
+	AssassinateCost     int `json:"assassinate_cost"`
+	ForcedCoupThreshold int `json:"forced_coup_threshold"` // players holding this many coins must coup
+	MinPlayers          int `json:"min_players"`
+	HandSize            int `json:"hand_size"`
+
+	// FirstRoundAmnesty spares challengers whose challenge fails during the first round
+	// from losing an influence; a claimant or blocker caught bluffing is still punished
+	FirstRoundAmnesty bool `json:"first_round_amnesty"`
+	// UnchallengeableBlocks makes every block stand without giving the actor a chance
+	// to challenge it
+	UnchallengeableBlocks bool `json:"unchallengeable_blocks"`
+
+	// Reformation plays the expansion: players split into factions that cannot attack
+	// each other, and may Convert and Embezzle
+	Reformation bool `json:"reformation"`
+	// Inquisitor replaces the Ambassador with the Inquisitor in the deck
+	Inquisitor bool `json:"inquisitor"`
+	// Deck picks the characters the deck is built from, overriding the standard five
+	Deck []Card `json:"deck,omitempty"`
+	// TwoPlayer plays the head-to-head rules: each player secretly picks their hand from a
+	// full set of characters and the starting player begins with a single coin
+	TwoPlayer bool `json:"two_player"`
+
+	// Timers limits how long players may take over each decision
+	Timers Timers `json:"timers"`
+	// TimeBank gives each player a chess clock on top of the decision timers
+	TimeBank TimeBank `json:"time_bank"`
+}
+
+// DefaultRuleSet returns the rules of the standard game
+func DefaultRuleSet() RuleSet {
+	return RuleSet{
+		StartingCoins:       StartingCoins,
+		CoupCost:            Coup.GetCost(),
+		AssassinateCost:     Assassinate.GetCost(),
+		ForcedCoupThreshold: 10,
+		MinPlayers:          3,
+		HandSize:            DefaultHandSize,
+	}
+}
+
+// TwoPlayerRuleSet returns the rules of the official two-player game
+func TwoPlayerRuleSet() RuleSet {
+	rules := DefaultRuleSet()
+	rules.MinPlayers = 2
+	rules.TwoPlayer = true
+	return rules
+}
+
+// Validate checks that a game can be played with the rules
+func (r RuleSet) Validate() error {
+	if r.StartingCoins < 0 {
+		return fmt.Errorf("starting coins cannot be negative: %d", r.StartingCoins)
+	}
+
+	if r.CoupCost < 1 {
+		return fmt.Errorf("coup cost must be at least 1: %d", r.CoupCost)
+	}
+
+	if r.AssassinateCost < 0 {
+		return fmt.Errorf("assassinate cost cannot be negative: %d", r.AssassinateCost)
+	}
+
+	if r.ForcedCoupThreshold < r.CoupCost {
+		return fmt.Errorf("forced coup threshold %d is below the coup cost %d", r.ForcedCoupThreshold, r.CoupCost)
+	}
+
+	if r.MinPlayers < 2 || r.MinPlayers > MaxTablePlayers {
+		return fmt.Errorf("minimum players must be between 2 and %d: %d", MaxTablePlayers, r.MinPlayers)
+	}
+
+	if r.HandSize < 1 || r.HandSize > MaxHandSize {
+		return fmt.Errorf("hand size must be between 1 and %d: %d", MaxHandSize, r.HandSize)
+	}
+
+	if err := r.Timers.Validate(); err != nil {
+		return err
+	}
+
+	if err := r.TimeBank.Validate(); err != nil {
+		return err
+	}
+
+	if len(r.Deck) > 0 {
+		if err := validateDeck(r.Deck, r.Inquisitor); err != nil {
+			return err
+		}
+	}
+
+	if r.TwoPlayer && r.MinPlayers != 2 {
+		return fmt.Errorf("two-player rules need a minimum of 2 players: %d", r.MinPlayers)
+	}
+
+	return nil
+}
+
+// validateDeck checks that a chosen deck has five different registered characters and that
+// no action could be claimed with two of them
+func validateDeck(deck []Card, inquisitor bool) error {
+	if inquisitor {
+		return fmt.Errorf("choose either a deck or the Inquisitor variant, not both")
+	}
+
+	if len(deck) != DeckCharacters {
+		return fmt.Errorf("deck must have %d characters, got %d", DeckCharacters, len(deck))
+	}
+
+	claimedBy := make(map[ActionType]Card)
+	for i, card := range deck {
+		character, exists := characters.get(card)
+		if !exists {
+			return fmt.Errorf("deck character %d is not registered", card)
+		}
+
+		for _, other := range deck[:i] {
+			if other == card {
+				return fmt.Errorf("deck has %s twice", card)
+			}
+		}
+
+		for _, ability := range character.Actions {
+			if other, claimed := claimedBy[ability.Action]; claimed {
+				return fmt.Errorf("both %s and %s perform %s", other, card, ability.Action)
+			}
+			claimedBy[ability.Action] = card
+		}
+	}
+
+	return nil
+}
+
+// Characters returns the characters the deck is built from
+func (r RuleSet) Characters() []Card {
+	if len(r.Deck) > 0 {
+		return r.Deck
+	}
+	if !r.Inquisitor {
+		return standardCharacters
+	}
+	return []Card{Duke, Assassin, Inquisitor, Captain, Contessa}
+}
+
+// HasCharacter reports whether the character is in the deck under the rules
+func (r RuleSet) HasCharacter(card Card) bool {
+	for _, c := range r.Characters() {
+		if c == card {
+			return true
+		}
+	}
+	return false
+}
+
+// ActionEnabled reports whether the action can be declared under the rules: character
+// actions need a character in the deck that performs them
+func (r RuleSet) ActionEnabled(action ActionType) bool {
+	switch {
+	case action.IsReformationAction():
+		return r.Reformation
+	case action.IsCharacterAction():
+		return r.RequiredCard(action) >= 0
+	default:
+		return true
+	}
+}
+
+// RequiredCard returns the character in the deck claimed to perform an action, or -1 if
+// none of them performs it
+func (r RuleSet) RequiredCard(action ActionType) Card {
+	for _, card := range r.Characters() {
+		if card.CanPerformAction(action) {
+			return card
+		}
+	}
+	return -1
+}
+
+// ability returns the deck character's ability for a character action
+func (r RuleSet) ability(action ActionType) (Ability, bool) {
+	character, exists := characters.get(r.RequiredCard(action))
+	if !exists {
+		return Ability{}, false
+	}
+	return character.Ability(action)
+}
+
+// Cost returns the coin cost of an action under the rules. Character actions cost what the
+// character performing them declares, except that the rule set's coup and assassination
+// costs always apply.
+func (r RuleSet) Cost(action ActionType) int {
+	switch action {
+	case Coup:
+		return r.CoupCost
+	case Assassinate:
+		return r.AssassinateCost
+	}
+
+	if ability, exists := r.ability(action); exists {
+		return ability.Cost
+	}
+	return action.GetCost()
+}
+
+// Reward returns the coins an action pays under the rules
+func (r RuleSet) Reward(action ActionType) int {
+	if ability, exists := r.ability(action); exists {
+		return ability.Reward
+	}
+	return action.GetReward()
+}
+
+// CanAfford checks if the player can pay for an action under the rules
+func (r RuleSet) CanAfford(player *Player, action ActionType) bool {
+	return player.Coins >= r.Cost(action)
+}
+
+// MustCoup reports whether the player holds enough coins to be forced to coup
+func (r RuleSet) MustCoup(player *Player) bool {
+	return player.Coins >= r.ForcedCoupThreshold
+}
+
+// actionCost returns what a player pays to declare an action against the target
+func (g *Game) actionCost(action ActionType, targetID string) int {
+	if action == Convert && targetID != "" {
+		return ConvertOtherCost
+	}
+	return g.Rules.Cost(action)
+}
+
+// SetRuleSet changes the rules before the game starts
+func (g *Game) SetRuleSet(rules RuleSet) error {
+	if g.State != Waiting {
+		return fmt.Errorf("cannot change rules: game is not in waiting state")
+	}
+
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+
+	if rules.TwoPlayer && len(g.Players) > 2 {
+		return fmt.Errorf("cannot use two-player rules with %d players", len(g.Players))
+	}
+
+	g.applyRuleSet(rules)
+	g.emit(Event{Type: EventRulesSet, Rules: &rules})
+	return nil
+}
+
+// applyRuleSet makes the game play by the rules
+func (g *Game) applyRuleSet(rules RuleSet) {
+	rules.Deck = cloneCards(rules.Deck)
+	g.Rules = rules
+	g.MinPlayers = rules.MinPlayers
+
+	g.MaxPlayers = MaxTablePlayers
+	if rules.TwoPlayer {
+		g.MaxPlayers = 2
+	}
+}
+
+// inAmnesty reports whether a challenger whose challenge fails currently goes unpunished
+func (g *Game) inAmnesty() bool {
+	return g.Rules.FirstRoundAmnesty && g.Round == 1
+}
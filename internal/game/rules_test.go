@@ -0,0 +1,241 @@
+package game
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// newRuledGame creates a started game with the given rules and number of players; opts
+// are applied after the rules
+func newRuledGame(t *testing.T, rules RuleSet, playerCount int, opts ...Option) *Game {
+	t.Helper()
+
+	game := NewGame("test", append([]Option{WithRuleSet(rules)}, opts...)...)
+	for i := 0; i < playerCount; i++ {
+		if err := game.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i))); err != nil {
+			t.Fatalf("AddPlayer() error = %v", err)
+		}
+	}
+
+	if err := game.StartGame(); err != nil {
+		t.Fatalf("StartGame() error = %v", err)
+	}
+
+	return game
+}
+
+// TDD: Test rule set validation
+func TestRuleSet_Validate(t *testing.T) {
+	if err := DefaultRuleSet().Validate(); err != nil {
+		t.Fatalf("DefaultRuleSet().Validate() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*RuleSet)
+	}{
+		{"negative starting coins", func(r *RuleSet) { r.StartingCoins = -1 }},
+		{"free coup", func(r *RuleSet) { r.CoupCost = 0 }},
+		{"negative assassinate cost", func(r *RuleSet) { r.AssassinateCost = -3 }},
+		{"forced coup below cost", func(r *RuleSet) { r.ForcedCoupThreshold = 5 }},
+		{"one player", func(r *RuleSet) { r.MinPlayers = 1 }},
+		{"too many players", func(r *RuleSet) { r.MinPlayers = MaxTablePlayers + 1 }},
+		{"empty hand", func(r *RuleSet) { r.HandSize = 0 }},
+		{"huge hand", func(r *RuleSet) { r.HandSize = MaxHandSize + 1 }},
+	}
+
+	for _, test := range tests {
+		rules := DefaultRuleSet()
+		test.modify(&rules)
+		if err := rules.Validate(); err == nil {
+			t.Errorf("Validate() with %s should fail", test.name)
+		}
+	}
+}
+
+// TDD: Test invalid rules keep the game from starting
+func TestGame_StartGame_InvalidRules(t *testing.T) {
+	rules := DefaultRuleSet()
+	rules.CoupCost = 0
+
+	game := NewGame("test", WithRuleSet(rules))
+	for i := 0; i < 3; i++ {
+		game.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i)))
+	}
+
+	if err := game.StartGame(); err == nil {
+		t.Error("StartGame() with invalid rules should fail")
+	}
+
+	if game.State != Waiting {
+		t.Errorf("State = %v, want Waiting", game.State)
+	}
+}
+
+// TDD: Test the numeric rules change costs, starting coins and hands
+func TestGame_Rules_Numbers(t *testing.T) {
+	rules := DefaultRuleSet()
+	rules.StartingCoins = 4
+	rules.CoupCost = 5
+	rules.ForcedCoupThreshold = 6
+	rules.HandSize = 3
+
+	game := newRuledGame(t, rules, 3)
+
+	if coins := game.Players["p0"].Coins; coins != 4 {
+		t.Errorf("Starting coins = %v, want 4", coins)
+	}
+
+	if cards := len(game.Players["p0"].Cards); cards != 3 {
+		t.Errorf("Hand size = %v, want 3", cards)
+	}
+
+	if err := game.PerformAction("p0", Coup, "p1"); err == nil {
+		t.Error("Coup with 4 coins should fail when it costs 5")
+	}
+
+	game.Players["p0"].Coins = 6
+	if err := game.PerformAction("p0", Income, ""); err == nil {
+		t.Error("Income with 6 coins should fail when the forced coup threshold is 6")
+	}
+
+	if err := game.PerformAction("p0", Coup, "p1"); err != nil {
+		t.Fatalf("PerformAction(Coup) error = %v", err)
+	}
+
+	if coins := game.Players["p0"].Coins; coins != 1 {
+		t.Errorf("Coins after coup = %v, want 1", coins)
+	}
+}
+
+// TDD: Test hands too large for the deck keep the game from starting
+func TestGame_StartGame_HandTooLarge(t *testing.T) {
+	rules := DefaultRuleSet()
+	rules.HandSize = MaxHandSize
+
+	game := NewGame("test", WithRuleSet(rules))
+	for i := 0; i < 6; i++ {
+		game.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i)))
+	}
+
+	if err := game.StartGame(); err == nil {
+		t.Error("StartGame() dealing 24 cards from a 15 card deck should fail")
+	}
+}
+
+// TDD: Test a lost challenge goes unpunished during the first round only
+func TestGame_Rules_FirstRoundAmnesty(t *testing.T) {
+	rules := DefaultRuleSet()
+	rules.FirstRoundAmnesty = true
+
+	game := newRuledGame(t, rules, 3)
+	game.Players["p0"].Cards = []Card{Duke, Contessa}
+
+	game.PerformAction("p0", Tax, "")
+	if _, err := game.Challenge("p1"); err != nil {
+		t.Fatalf("Challenge() error = %v", err)
+	}
+
+	if cards := len(game.Players["p1"].Cards); cards != 2 {
+		t.Errorf("Challenger cards in the first round = %v, want 2", cards)
+	}
+
+	if game.Phase != PhaseAction || game.Players["p0"].Coins != 5 {
+		t.Errorf("Phase = %v, coins = %v, want the tax resolved", game.Phase, game.Players["p0"].Coins)
+	}
+
+	game.PerformAction("p1", Income, "")
+	game.PerformAction("p2", Income, "")
+	if game.Round != 2 {
+		t.Fatalf("Round = %v, want 2", game.Round)
+	}
+
+	game.Players["p0"].Cards = []Card{Duke, Contessa}
+	game.PerformAction("p0", Tax, "")
+	game.Challenge("p1")
+
+	if game.Phase != PhaseInfluenceLoss || game.InfluenceLoss.PlayerID != "p1" {
+		t.Errorf("Phase = %v, want p1 to lose an influence after the first round", game.Phase)
+	}
+}
+
+// TDD: Test the first-round amnesty does not spare a player caught bluffing
+func TestGame_Rules_FirstRoundAmnesty_Bluff(t *testing.T) {
+	rules := DefaultRuleSet()
+	rules.FirstRoundAmnesty = true
+
+	game := newRuledGame(t, rules, 3)
+	game.Players["p0"].Cards = []Card{Captain, Contessa}
+
+	game.PerformAction("p0", Tax, "")
+	outcome, err := game.Challenge("p1")
+	if err != nil {
+		t.Fatalf("Challenge() error = %v", err)
+	}
+
+	if !outcome.Successful {
+		t.Fatal("Challenge() of a bluffed Tax should succeed")
+	}
+	if game.Phase != PhaseInfluenceLoss || game.InfluenceLoss.PlayerID != "p0" {
+		t.Errorf("Phase = %v, want p0 to lose an influence for bluffing in the first round", game.Phase)
+	}
+}
+
+// TDD: Test blocks stand immediately when they cannot be challenged
+func TestGame_Rules_UnchallengeableBlocks(t *testing.T) {
+	rules := DefaultRuleSet()
+	rules.UnchallengeableBlocks = true
+
+	game := newRuledGame(t, rules, 3)
+
+	game.PerformAction("p0", ForeignAid, "")
+	if err := game.Block("p1", Duke); err != nil {
+		t.Fatalf("Block() error = %v", err)
+	}
+
+	if game.Phase != PhaseAction || game.GetCurrentPlayer().ID != "p1" {
+		t.Errorf("Phase = %v, current = %v, want the next turn", game.Phase, game.GetCurrentPlayer().ID)
+	}
+
+	if coins := game.Players["p0"].Coins; coins != 2 {
+		t.Errorf("Blocked actor coins = %v, want 2", coins)
+	}
+}
+
+// TDD: Test rules can only change before the game starts and are shown to everyone
+func TestGame_SetRuleSet(t *testing.T) {
+	game := NewGame("test")
+
+	rules := DefaultRuleSet()
+	rules.StartingCoins = 3
+	if err := game.SetRuleSet(rules); err != nil {
+		t.Fatalf("SetRuleSet() error = %v", err)
+	}
+
+	rules.MinPlayers = 0
+	if err := game.SetRuleSet(rules); err == nil {
+		t.Error("SetRuleSet() with invalid rules should fail")
+	}
+
+	for i := 0; i < 3; i++ {
+		game.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i)))
+	}
+	game.StartGame()
+
+	if err := game.SetRuleSet(DefaultRuleSet()); err == nil {
+		t.Error("SetRuleSet() after the game started should fail")
+	}
+
+	if shown := game.GetGameState()["rules"].(RuleSet); shown.StartingCoins != 3 {
+		t.Errorf("GetGameState() rules = %+v, want starting coins 3", shown)
+	}
+
+	rebuilt, err := Rebuild(game.Events(), nil)
+	if err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+	if !reflect.DeepEqual(rebuilt.Rules, game.Rules) {
+		t.Errorf("Rebuilt rules = %+v, want %+v", rebuilt.Rules, game.Rules)
+	}
+}
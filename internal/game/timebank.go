@@ -0,0 +1,131 @@
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeBank configures a chess clock for each player: a total allowance that only runs
+// while the player owes a decision, topped up by an increment after each of their moves.
+// A zero Base turns the time banks off.
+type TimeBank struct {
+	Base      time.Duration `json:"base"`
+	Increment time.Duration `json:"increment"`
+	// Eliminate knocks out a player whose bank runs out instead of taking one influence
+	Eliminate bool `json:"eliminate"`
+}
+
+// Enabled reports whether players play against a time bank
+func (tb TimeBank) Enabled() bool {
+	return tb.Base > 0
+}
+
+// Validate checks that the time bank durations are not negative
+func (tb TimeBank) Validate() error {
+	if tb.Base < 0 || tb.Increment < 0 {
+		return fmt.Errorf("time bank cannot be negative: %+v", tb)
+	}
+	return nil
+}
+
+// dealTimeBanks fills every player's time bank at the start of the game
+func (g *Game) dealTimeBanks() {
+	g.bankedAt = g.clock.Now()
+	if !g.Rules.TimeBank.Enabled() {
+		return
+	}
+
+	for _, playerID := range g.PlayerOrder {
+		player := g.Players[playerID]
+		player.TimeBank = g.Rules.TimeBank.Base
+		player.hasTimeBank = true
+	}
+}
+
+// chargeTimeBanks takes the time spent since the last charge from the banks of the players
+// who owe the pending decision, and credits the increment to the player answering it.
+// The starting draft is not charged.
+func (g *Game) chargeTimeBanks(answeredBy string) {
+	now := g.clock.Now()
+	elapsed := now.Sub(g.bankedAt)
+	g.bankedAt = now
+
+	if !g.Rules.TimeBank.Enabled() || g.State != Playing || g.Phase == PhaseDraft {
+		return
+	}
+
+	decision := g.pendingDecision()
+	if decision == nil {
+		return
+	}
+
+	for _, playerID := range decision.PlayerIDs {
+		player := g.Players[playerID]
+		player.TimeBank -= elapsed
+		if player.TimeBank < 0 {
+			player.TimeBank = 0
+		}
+		if playerID == answeredBy {
+			player.TimeBank += g.Rules.TimeBank.Increment
+		}
+	}
+}
+
+// expiredTimeBank returns the first player owing the pending decision whose bank is empty
+func (g *Game) expiredTimeBank() string {
+	if !g.Rules.TimeBank.Enabled() || g.Phase == PhaseDraft {
+		return ""
+	}
+
+	decision := g.pendingDecision()
+	if decision == nil {
+		return ""
+	}
+
+	for _, playerID := range decision.PlayerIDs {
+		if g.Players[playerID].TimeBank <= 0 {
+			return playerID
+		}
+	}
+	return ""
+}
+
+// expireTimeBank records that a player ran out of time. Their bank is refilled and they pay
+// the penalty once the turn is over, when no decision is left half made.
+func (g *Game) expireTimeBank(playerID string) error {
+	player, exists := g.Players[playerID]
+	if !exists {
+		return fmt.Errorf("player with ID %s not found", playerID)
+	}
+	if !player.hasTimeBank {
+		return fmt.Errorf("player %s has no time bank", playerID)
+	}
+
+	g.emit(Event{Type: EventTimeExpired, PlayerID: playerID})
+
+	player.TimeBank = g.Rules.TimeBank.Base
+	g.timePenalties = append(g.timePenalties, playerID)
+	return nil
+}
+
+// applyTimePenalty makes the next player who ran out of time lose an influence, or all of
+// them when the rules eliminate such players, then carries on finishing the turn
+func (g *Game) applyTimePenalty() error {
+	player := g.Players[g.timePenalties[0]]
+	g.timePenalties = g.timePenalties[1:]
+
+	if !player.IsAlive {
+		return g.finishTurn()
+	}
+
+	if g.Rules.TimeBank.Eliminate {
+		for len(player.Cards) > 0 {
+			if err := g.revealCard(player, player.Cards[0]); err != nil {
+				return err
+			}
+		}
+		return g.finishTurn()
+	}
+
+	return g.requireInfluenceLoss(player, afterLossFinish)
+}
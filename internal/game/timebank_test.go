@@ -0,0 +1,120 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// newTimeBankGame creates a started game on a fake clock with 5 minute time banks
+func newTimeBankGame(t *testing.T, eliminate bool) (*Game, *FakeClock) {
+	t.Helper()
+
+	rules := DefaultRuleSet()
+	rules.TimeBank = TimeBank{Base: 5 * time.Minute, Increment: 5 * time.Second, Eliminate: eliminate}
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	return newRuledGame(t, rules, 3, WithSeed(3), WithClock(clock)), clock
+}
+
+// TDD: Test time bank validation and its place in the public info
+func TestTimeBank(t *testing.T) {
+	rules := DefaultRuleSet()
+	rules.TimeBank.Increment = -time.Second
+	if err := rules.Validate(); err == nil {
+		t.Error("Validate() should reject a negative increment")
+	}
+
+	if _, ok := newStartedGame(t, 3).Players["p0"].GetPublicInfo()["time_bank_ms"]; ok {
+		t.Error("GetPublicInfo() should not show a time bank when the rules have none")
+	}
+
+	game, _ := newTimeBankGame(t, false)
+	if bank := game.Players["p0"].GetPublicInfo()["time_bank_ms"]; bank != int64(300000) {
+		t.Errorf("time_bank_ms = %v, want 300000", bank)
+	}
+}
+
+// TDD: Test the bank only runs while the player owes a decision and gains the increment
+func TestTimeBank_Charge(t *testing.T) {
+	game, clock := newTimeBankGame(t, false)
+
+	clock.Advance(time.Minute)
+	game.PerformAction("p0", Income, "")
+
+	if bank := game.Players["p0"].TimeBank; bank != 4*time.Minute+5*time.Second {
+		t.Errorf("p0 TimeBank = %v, want 4m5s", bank)
+	}
+	if bank := game.Players["p1"].TimeBank; bank != 5*time.Minute {
+		t.Errorf("p1 TimeBank = %v, want 5m0s", bank)
+	}
+
+	// Everyone else owes the challenge window on a Tax
+	clock.Advance(30 * time.Second)
+	game.PerformAction("p1", Tax, "")
+	clock.Advance(10 * time.Second)
+	game.Tick()
+
+	if bank := game.Players["p0"].TimeBank; bank != 3*time.Minute+55*time.Second {
+		t.Errorf("p0 TimeBank = %v, want 3m55s", bank)
+	}
+	if bank := game.Players["p1"].TimeBank; bank != 4*time.Minute+35*time.Second {
+		t.Errorf("p1 TimeBank = %v, want 4m35s", bank)
+	}
+}
+
+// TDD: Test running out of time costs an influence once the turn is over
+func TestTimeBank_LoseInfluence(t *testing.T) {
+	game, clock := newTimeBankGame(t, false)
+
+	clock.Advance(5 * time.Minute)
+	timedOut, err := game.Tick()
+	if err != nil || !timedOut {
+		t.Fatalf("Tick() = %v, %v, want true, nil", timedOut, err)
+	}
+
+	// The fallback Income is taken, then p0 pays for running out of time
+	if coins := game.Players["p0"].Coins; coins != StartingCoins+1 {
+		t.Errorf("p0 coins = %d, want %d", coins, StartingCoins+1)
+	}
+	if game.Phase != PhaseInfluenceLoss || game.InfluenceLoss.PlayerID != "p0" {
+		t.Fatalf("Phase = %s, want p0 losing an influence", game.Phase)
+	}
+	if bank := game.Players["p0"].TimeBank; bank != 5*time.Minute {
+		t.Errorf("p0 TimeBank = %v, want a refilled bank", bank)
+	}
+
+	if err := game.ChooseInfluenceLoss("p0", game.Players["p0"].Cards[0]); err != nil {
+		t.Fatalf("ChooseInfluenceLoss() error = %v", err)
+	}
+	if game.Phase != PhaseAction || game.CurrentPlayer != 1 {
+		t.Errorf("Phase = %s, CurrentPlayer = %d, want p1's action", game.Phase, game.CurrentPlayer)
+	}
+	if !game.Players["p0"].IsAlive {
+		t.Error("p0 should survive losing one influence")
+	}
+}
+
+// TDD: Test running out of time eliminates the player when configured
+func TestTimeBank_Eliminate(t *testing.T) {
+	game, clock := newTimeBankGame(t, true)
+
+	clock.Advance(5 * time.Minute)
+	if _, err := game.Tick(); err != nil {
+		t.Fatalf("Tick() error = %v", err)
+	}
+
+	if game.Players["p0"].IsAlive {
+		t.Error("p0 should be eliminated after running out of time")
+	}
+	if game.Phase != PhaseAction || game.CurrentPlayer != 1 {
+		t.Errorf("Phase = %s, CurrentPlayer = %d, want p1's action", game.Phase, game.CurrentPlayer)
+	}
+
+	rebuilt, err := Rebuild(game.Events(), clock)
+	if err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+	if rebuilt.Players["p0"].IsAlive {
+		t.Error("Rebuild() should replay the time penalty")
+	}
+}
@@ -0,0 +1,143 @@
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// Timers limits how long each kind of decision may take. A zero duration means the
+// decision waits for as long as it takes.
+type Timers struct {
+	Action    time.Duration `json:"action"`    // declaring an action
+	Challenge time.Duration `json:"challenge"` // the window to challenge a claim
+	Block     time.Duration `json:"block"`     // the windows to block, and to challenge a block
+	Card      time.Duration `json:"card"`      // picking cards to lose, keep, draft or show
+}
+
+// DefaultTimers returns timer durations suited to online play
+func DefaultTimers() Timers {
+	return Timers{
+		Action:    60 * time.Second,
+		Challenge: 15 * time.Second,
+		Block:     15 * time.Second,
+		Card:      30 * time.Second,
+	}
+}
+
+// Validate checks that no timer is negative
+func (t Timers) Validate() error {
+	if t.Action < 0 || t.Challenge < 0 || t.Block < 0 || t.Card < 0 {
+		return fmt.Errorf("timers cannot be negative: %+v", t)
+	}
+	return nil
+}
+
+// For returns the time limit of decisions in a turn phase
+func (t Timers) For(phase TurnPhase) time.Duration {
+	switch phase {
+	case PhaseAction:
+		return t.Action
+	case PhaseChallenge:
+		return t.Challenge
+	case PhaseBlock, PhaseBlockChallenge:
+		return t.Block
+	case PhaseInfluenceLoss, PhaseExchange, PhaseDraft, PhaseShowCard, PhaseExamine:
+		return t.Card
+	default:
+		return 0
+	}
+}
+
+// Deadline returns when the pending decision times out, or nil if it has no time limit
+func (g *Game) Deadline() *time.Time {
+	limit := g.Rules.Timers.For(g.Phase)
+	if g.State != Playing || limit <= 0 {
+		return nil
+	}
+
+	deadline := g.phaseStarted.Add(limit)
+	return &deadline
+}
+
+// Tick applies the fallback moves of a decision whose deadline has passed: Income (or a
+// forced Coup) for the active player, passing for reactions, and a random pick for card
+// choices. Players whose time bank ran out make the same fallback move for the decision
+// they owe. It reports whether anyone had timed out.
+func (g *Game) Tick() (bool, error) {
+	if g.State != Playing {
+		return false, nil
+	}
+
+	g.chargeTimeBanks("")
+
+	timedOut := false
+	for playerID := g.expiredTimeBank(); playerID != ""; playerID = g.expiredTimeBank() {
+		timedOut = true
+		if err := g.expireTimeBank(playerID); err != nil {
+			return true, err
+		}
+		if err := g.timeOut(playerID); err != nil {
+			return true, err
+		}
+	}
+
+	deadline := g.Deadline()
+	if deadline == nil || g.clock.Now().Before(*deadline) {
+		return timedOut, nil
+	}
+
+	phase := g.Phase
+	for _, playerID := range g.PendingDecision().PlayerIDs {
+		if g.Phase != phase {
+			// An earlier fallback already closed the decision
+			break
+		}
+
+		if err := g.timeOut(playerID); err != nil {
+			return true, err
+		}
+	}
+
+	return true, nil
+}
+
+// timeOut makes the fallback move for a player who ran out of time
+func (g *Game) timeOut(playerID string) error {
+	g.timingOut = true
+	defer func() { g.timingOut = false }()
+
+	move, err := g.fallbackMove(playerID)
+	if err != nil {
+		return err
+	}
+	if err := g.ApplyMove(move); err != nil {
+		return fmt.Errorf("timing out %s: %w", playerID, err)
+	}
+	return nil
+}
+
+// fallbackMove picks the move made for a player who let their decision time out
+func (g *Game) fallbackMove(playerID string) (Move, error) {
+	moves := g.LegalActions(playerID)
+	if len(moves) == 0 {
+		return Move{}, fmt.Errorf("player %s has no move to time out with", playerID)
+	}
+
+	switch g.Phase {
+	case PhaseAction:
+		if !g.Rules.MustCoup(g.Players[playerID]) {
+			return Move{Kind: MoveAction, PlayerID: playerID, Action: Income}, nil
+		}
+		// Every legal move is a Coup: aim it at a random target
+		return moves[g.timeoutRng.Intn(len(moves))], nil
+	case PhaseChallenge, PhaseBlock, PhaseBlockChallenge:
+		return Move{Kind: MovePass, PlayerID: playerID}, nil
+	case PhaseExchange:
+		// Keep the hand the player had before drawing
+		return Move{Kind: MoveExchange, PlayerID: playerID, Cards: cloneCards(g.Players[playerID].Cards)}, nil
+	case PhaseExamine:
+		return Move{Kind: MoveExamine, PlayerID: playerID}, nil
+	default:
+		return moves[g.timeoutRng.Intn(len(moves))], nil
+	}
+}
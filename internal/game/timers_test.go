@@ -0,0 +1,206 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// newTimedGame creates a started game on a fake clock with the default timers
+func newTimedGame(t *testing.T, playerCount int) (*Game, *FakeClock) {
+	t.Helper()
+
+	rules := DefaultRuleSet()
+	rules.Timers = DefaultTimers()
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	return newRuledGame(t, rules, playerCount, WithSeed(7), WithClock(clock)), clock
+}
+
+// TDD: Test timers map each phase to its duration and reject negative values
+func TestTimers(t *testing.T) {
+	timers := DefaultTimers()
+
+	tests := []struct {
+		phase TurnPhase
+		want  time.Duration
+	}{
+		{PhaseAction, timers.Action},
+		{PhaseChallenge, timers.Challenge},
+		{PhaseBlock, timers.Block},
+		{PhaseBlockChallenge, timers.Block},
+		{PhaseInfluenceLoss, timers.Card},
+		{PhaseExchange, timers.Card},
+		{PhaseNone, 0},
+	}
+	for _, tt := range tests {
+		if got := timers.For(tt.phase); got != tt.want {
+			t.Errorf("For(%s) = %v, want %v", tt.phase, got, tt.want)
+		}
+	}
+
+	rules := DefaultRuleSet()
+	rules.Timers.Block = -time.Second
+	if err := rules.Validate(); err == nil {
+		t.Error("Validate() should reject a negative timer")
+	}
+}
+
+// TDD: Test deadlines are shown to clients and absent without timers
+func TestGame_Deadline(t *testing.T) {
+	if deadline := newStartedGame(t, 3).Deadline(); deadline != nil {
+		t.Errorf("Deadline() = %v without timers, want nil", deadline)
+	}
+
+	game, clock := newTimedGame(t, 3)
+	start := clock.Now()
+
+	want := start.Add(DefaultTimers().Action)
+	if deadline := game.Deadline(); deadline == nil || !deadline.Equal(want) {
+		t.Errorf("Deadline() = %v, want %v", deadline, want)
+	}
+
+	decision := game.GetGameState()["pending_decision"].(map[string]interface{})
+	if decision["deadline"] != want {
+		t.Errorf("pending_decision deadline = %v, want %v", decision["deadline"], want)
+	}
+
+	clock.Advance(10 * time.Second)
+	game.PerformAction("p0", Tax, "")
+	want = clock.Now().Add(DefaultTimers().Challenge)
+	if deadline := game.Deadline(); !deadline.Equal(want) {
+		t.Errorf("Deadline() after Tax = %v, want %v", deadline, want)
+	}
+}
+
+// TDD: Test nothing happens before the deadline
+func TestGame_Tick_BeforeDeadline(t *testing.T) {
+	game, clock := newTimedGame(t, 3)
+
+	clock.Advance(DefaultTimers().Action - time.Second)
+	timedOut, err := game.Tick()
+	if err != nil || timedOut {
+		t.Fatalf("Tick() = %v, %v, want false, nil", timedOut, err)
+	}
+	if game.Phase != PhaseAction || game.CurrentPlayer != 0 {
+		t.Error("Tick() should not move the game before the deadline")
+	}
+}
+
+// TDD: Test an idle player takes Income and the command is marked as timed out
+func TestGame_Tick_Income(t *testing.T) {
+	game, clock := newTimedGame(t, 3)
+
+	clock.Advance(DefaultTimers().Action)
+	timedOut, err := game.Tick()
+	if err != nil || !timedOut {
+		t.Fatalf("Tick() = %v, %v, want true, nil", timedOut, err)
+	}
+
+	if coins := game.Players["p0"].Coins; coins != StartingCoins+1 {
+		t.Errorf("p0 coins = %d, want %d", coins, StartingCoins+1)
+	}
+	if game.CurrentPlayer != 1 {
+		t.Errorf("CurrentPlayer = %d, want 1", game.CurrentPlayer)
+	}
+
+	var declared *Event
+	for i, event := range game.Events() {
+		if event.Type == EventActionDeclared {
+			declared = &game.Events()[i]
+		}
+	}
+	if declared == nil || !declared.TimedOut {
+		t.Errorf("ActionDeclared event = %+v, want it marked as timed out", declared)
+	}
+}
+
+// TDD: Test a player forced to coup launches a Coup when the timer runs out
+func TestGame_Tick_ForcedCoup(t *testing.T) {
+	game, clock := newTimedGame(t, 3)
+	game.Players["p0"].Coins = DefaultRuleSet().ForcedCoupThreshold
+
+	clock.Advance(DefaultTimers().Action)
+	if _, err := game.Tick(); err != nil {
+		t.Fatalf("Tick() error = %v", err)
+	}
+
+	if game.Phase != PhaseInfluenceLoss {
+		t.Fatalf("Phase = %s, want %s", game.Phase, PhaseInfluenceLoss)
+	}
+	if target := game.InfluenceLoss.PlayerID; target == "p0" {
+		t.Error("the forced Coup should target another player")
+	}
+}
+
+// TDD: Test reactions pass and card choices are made at random
+func TestGame_Tick_Reactions(t *testing.T) {
+	game, clock := newTimedGame(t, 3)
+	game.Players["p0"].Coins = 3
+	game.PerformAction("p0", Assassinate, "p1")
+
+	// Challenge window: everyone passes
+	clock.Advance(DefaultTimers().Challenge)
+	game.Tick()
+	if game.Phase != PhaseBlock {
+		t.Fatalf("Phase = %s, want %s", game.Phase, PhaseBlock)
+	}
+
+	// Block window: the target passes and loses an influence
+	clock.Advance(DefaultTimers().Block)
+	game.Tick()
+	if game.Phase != PhaseInfluenceLoss || game.InfluenceLoss.PlayerID != "p1" {
+		t.Fatalf("Phase = %s, want p1 losing an influence", game.Phase)
+	}
+
+	// Card choice: a random card is revealed
+	clock.Advance(DefaultTimers().Card)
+	game.Tick()
+	if cards := len(game.Players["p1"].Cards); cards != DefaultHandSize-1 {
+		t.Errorf("p1 has %d cards, want %d", cards, DefaultHandSize-1)
+	}
+	if game.Phase != PhaseAction || game.CurrentPlayer != 1 {
+		t.Errorf("Phase = %s, CurrentPlayer = %d, want p1's action", game.Phase, game.CurrentPlayer)
+	}
+}
+
+// TDD: Test a timed-out exchange keeps the original hand
+func TestGame_Tick_Exchange(t *testing.T) {
+	game, clock := newTimedGame(t, 3)
+	hand := cloneCards(game.Players["p0"].Cards)
+
+	game.PerformAction("p0", Exchange, "")
+	passAll(t, game)
+
+	clock.Advance(DefaultTimers().Card)
+	game.Tick()
+
+	if game.Phase != PhaseAction {
+		t.Fatalf("Phase = %s, want %s", game.Phase, PhaseAction)
+	}
+	for i, card := range game.Players["p0"].Cards {
+		if card != hand[i] {
+			t.Errorf("p0 cards = %v, want %v", game.Players["p0"].Cards, hand)
+			break
+		}
+	}
+}
+
+// TDD: Test games with timed-out moves replay exactly
+func TestGame_Tick_Replay(t *testing.T) {
+	game, clock := newTimedGame(t, 3)
+
+	for i := 0; i < 12 && game.State == Playing; i++ {
+		clock.Advance(DefaultTimers().Action)
+		if _, err := game.Tick(); err != nil {
+			t.Fatalf("Tick() error = %v", err)
+		}
+	}
+
+	rebuilt, err := Rebuild(game.Events(), clock)
+	if err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+	if rebuilt.CurrentPlayer != game.CurrentPlayer || rebuilt.Players["p0"].Coins != game.Players["p0"].Coins {
+		t.Error("Rebuild() should reach the same state")
+	}
+}
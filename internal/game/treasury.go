@@ -0,0 +1,151 @@
+package game
+
+import "fmt"
+
+const (
+	// DefaultTreasurySize is the number of coins in the physical game's treasury
+	DefaultTreasurySize = 50
+	// StartingCoins is the number of coins each player takes from the treasury at the start
+	StartingCoins = 2
+
+	// reserveName marks coin movements into or out of the Treasury Reserve
+	reserveName = "reserve"
+)
+
+// Treasury holds every coin that is not in a player's hand. In the Reformation variant part
+// of it is set aside as the Treasury Reserve, fed by conversions and emptied by embezzlement.
+type Treasury struct {
+	Size    int `json:"size"`
+	Coins   int `json:"coins"`
+	Reserve int `json:"reserve"`
+}
+
+// NewTreasury creates a full treasury with the given number of coins
+func NewTreasury(size int) *Treasury {
+	return &Treasury{
+		Size:  size,
+		Coins: size,
+	}
+}
+
+// SetTreasurySize changes the number of coins in play before the game starts
+func (g *Game) SetTreasurySize(size int) error {
+	if g.State != Waiting {
+		return fmt.Errorf("cannot change treasury: game is not in waiting state")
+	}
+
+	if size < 0 {
+		return fmt.Errorf("treasury size cannot be negative: %d", size)
+	}
+
+	g.Treasury = NewTreasury(size)
+	g.emit(Event{Type: EventTreasurySized, Amount: size})
+	return nil
+}
+
+// CheckCoinInvariant verifies that no coins have been created or destroyed: once the game
+// has started, the coins in the treasury and its reserve plus the coins in every player's
+// hand always add up to the treasury size. Before then players hold the purse NewPlayer
+// gives them, which StartGame replaces with starting coins paid from the treasury.
+func (g *Game) CheckCoinInvariant() error {
+	if g.State == Waiting {
+		return nil
+	}
+
+	total := g.Treasury.Coins + g.Treasury.Reserve
+	for _, player := range g.Players {
+		total += player.Coins
+	}
+
+	if total != g.Treasury.Size {
+		return fmt.Errorf("coin invariant violated: %d coins in play, want %d", total, g.Treasury.Size)
+	}
+	return nil
+}
+
+// takeFromTreasury gives a player up to amount coins, limited by what the treasury holds,
+// and returns the number of coins actually paid
+func (g *Game) takeFromTreasury(player *Player, amount int) int {
+	if amount > g.Treasury.Coins {
+		amount = g.Treasury.Coins
+	}
+
+	g.Treasury.Coins -= amount
+	player.AddCoins(amount)
+	g.emitCoinsMoved("", player.ID, amount)
+	return amount
+}
+
+// payToTreasury moves coins from a player's hand back into the treasury
+func (g *Game) payToTreasury(player *Player, amount int) error {
+	if err := player.RemoveCoins(amount); err != nil {
+		return err
+	}
+
+	g.Treasury.Coins += amount
+	g.emitCoinsMoved(player.ID, "", amount)
+	return nil
+}
+
+// payToReserve moves coins from a player's hand into the Treasury Reserve
+func (g *Game) payToReserve(player *Player, amount int) error {
+	if err := player.RemoveCoins(amount); err != nil {
+		return err
+	}
+
+	g.Treasury.Reserve += amount
+	if amount > 0 {
+		g.emit(Event{Type: EventCoinsMoved, PlayerID: player.ID, Name: reserveName, Amount: amount})
+	}
+	return nil
+}
+
+// takeReserve gives a player every coin in the Treasury Reserve and returns how many
+func (g *Game) takeReserve(player *Player) int {
+	amount := g.Treasury.Reserve
+
+	g.Treasury.Reserve = 0
+	player.AddCoins(amount)
+	if amount > 0 {
+		g.emit(Event{Type: EventCoinsMoved, TargetID: player.ID, Name: reserveName, Amount: amount})
+	}
+	return amount
+}
+
+// transferCoins moves up to amount coins between players, limited by what the payer holds,
+// and returns the number of coins actually moved
+func (g *Game) transferCoins(from, to *Player, amount int) int {
+	if amount > from.Coins {
+		amount = from.Coins
+	}
+
+	from.RemoveCoins(amount)
+	to.AddCoins(amount)
+	g.emitCoinsMoved(from.ID, to.ID, amount)
+	return amount
+}
+
+// emitCoinsMoved records a coin movement; an empty ID stands for the treasury
+func (g *Game) emitCoinsMoved(fromID, toID string, amount int) {
+	if amount > 0 {
+		g.emit(Event{Type: EventCoinsMoved, PlayerID: fromID, TargetID: toID, Amount: amount})
+	}
+}
+
+// dealStartingCoins resets the treasury and pays every player their starting coins from it
+func (g *Game) dealStartingCoins() {
+	g.Treasury.Coins = g.Treasury.Size
+	g.Treasury.Reserve = 0
+
+	for i, playerID := range g.PlayerOrder {
+		player := g.Players[playerID]
+		player.Coins = 0
+
+		coins := g.Rules.StartingCoins
+		if g.Rules.TwoPlayer && i == 0 && coins > TwoPlayerFirstCoins {
+			// The starting player's first move makes up for their smaller purse
+			coins = TwoPlayerFirstCoins
+		}
+		g.takeFromTreasury(player, coins)
+	}
+}
@@ -0,0 +1,114 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TDD: Test starting coins come out of the treasury
+func TestGame_StartGame_Treasury(t *testing.T) {
+	game := newStartedGame(t, 3)
+
+	if game.Treasury.Coins != DefaultTreasurySize-6 {
+		t.Errorf("Treasury coins = %v, want %v", game.Treasury.Coins, DefaultTreasurySize-6)
+	}
+
+	if err := game.CheckCoinInvariant(); err != nil {
+		t.Errorf("CheckCoinInvariant() error = %v, want nil", err)
+	}
+
+	if state := game.GetGameState(); state["treasury"] != DefaultTreasurySize-6 {
+		t.Errorf("Game state treasury = %v, want %v", state["treasury"], DefaultTreasurySize-6)
+	}
+}
+
+// TDD: Test the coin invariant only covers games that have started
+func TestGame_CheckCoinInvariant_Waiting(t *testing.T) {
+	game := NewGame("test")
+	for i := 0; i < 3; i++ {
+		game.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i)))
+	}
+
+	if err := game.CheckCoinInvariant(); err != nil {
+		t.Errorf("CheckCoinInvariant() before the start error = %v, want nil", err)
+	}
+
+	if err := game.StartGame(); err != nil {
+		t.Fatalf("StartGame() error = %v", err)
+	}
+	if err := game.CheckCoinInvariant(); err != nil {
+		t.Errorf("CheckCoinInvariant() after the start error = %v, want nil", err)
+	}
+
+	game.Players["p0"].Coins++
+	if err := game.CheckCoinInvariant(); err == nil {
+		t.Error("CheckCoinInvariant() should catch a coin created during the game")
+	}
+}
+
+// TDD: Test configuring the treasury size
+func TestGame_SetTreasurySize(t *testing.T) {
+	game := NewGame("test")
+
+	if err := game.SetTreasurySize(-1); err == nil {
+		t.Error("SetTreasurySize() should return error for a negative size")
+	}
+
+	if err := game.SetTreasurySize(5); err != nil {
+		t.Errorf("SetTreasurySize() error = %v, want nil", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		game.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i)))
+	}
+
+	if err := game.StartGame(); err == nil {
+		t.Error("StartGame() should return error when the treasury cannot pay starting coins")
+	}
+
+	game = newStartedGame(t, 3)
+	if err := game.SetTreasurySize(100); err == nil {
+		t.Error("SetTreasurySize() should return error once the game has started")
+	}
+}
+
+// TDD: Test an empty treasury pays nothing
+func TestGame_Income_EmptyTreasury(t *testing.T) {
+	game := NewGame("test")
+	game.SetTreasurySize(6)
+	for i := 0; i < 3; i++ {
+		game.AddPlayer(NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i)))
+	}
+	game.StartGame()
+
+	if err := game.PerformAction("p0", Income, ""); err != nil {
+		t.Fatalf("PerformAction(Income) error = %v, want nil", err)
+	}
+
+	if coins := game.Players["p0"].Coins; coins != 2 {
+		t.Errorf("Coins after Income from empty treasury = %v, want 2", coins)
+	}
+
+	if err := game.CheckCoinInvariant(); err != nil {
+		t.Errorf("CheckCoinInvariant() error = %v, want nil", err)
+	}
+}
+
+// TDD: Test coins are conserved through a whole game
+func TestGame_CoinInvariant_FullGame(t *testing.T) {
+	game := newStartedGame(t, 5)
+
+	for turn := 0; turn < 500 && game.State == Playing; turn++ {
+		playerID := game.PendingDecision().PlayerIDs[0]
+		moves := game.LegalActions(playerID)
+		move := moves[(turn*7)%len(moves)]
+
+		if err := game.ApplyMove(move); err != nil {
+			t.Fatalf("ApplyMove(%+v) error = %v", move, err)
+		}
+
+		if err := game.CheckCoinInvariant(); err != nil {
+			t.Fatalf("after %+v: %v", move, err)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package game
+
+import "fmt"
+
+// PerformAction validates and executes an action for the current player, then advances the turn.
+// Character actions and blockable actions are only declared here: they resolve once their
+// challenge and block phases have closed.
+func (g *Game) PerformAction(playerID string, action ActionType, targetID string) error {
+	player, err := g.validateAction(playerID, action, targetID)
+	if err != nil {
+		return err
+	}
+
+	g.emit(Event{Type: EventActionDeclared, PlayerID: playerID, Action: action, TargetID: targetID})
+
+	if action == Convert {
+		err = g.payToReserve(player, g.actionCost(action, targetID))
+	} else {
+		err = g.payToTreasury(player, g.actionCost(action, targetID))
+	}
+	if err != nil {
+		return err
+	}
+
+	var target *Player
+	if targetID != "" {
+		target = g.Players[targetID]
+	}
+
+	if !action.IsChallengeable() && !action.CanBeBlocked() {
+		return g.resolveAction(player, action, target)
+	}
+
+	g.PendingAction = &PendingAction{
+		ActorID:  playerID,
+		Action:   action,
+		Claim:    g.Rules.RequiredCard(action),
+		TargetID: targetID,
+		Passed:   make(map[string]bool),
+	}
+
+	if !action.IsChallengeable() {
+		return g.openBlockPhase()
+	}
+	return g.transition(PhaseChallenge)
+}
+
+// validateAction checks turn order, affordability, forced coup and targeting rules
+func (g *Game) validateAction(playerID string, action ActionType, targetID string) (*Player, error) {
+	if err := g.requirePhase("perform action", PhaseAction); err != nil {
+		return nil, err
+	}
+
+	player, exists := g.Players[playerID]
+	if !exists {
+		return nil, fmt.Errorf("player with ID %s not found", playerID)
+	}
+
+	if current := g.GetCurrentPlayer(); current == nil || current.ID != playerID {
+		return nil, fmt.Errorf("it is not player %s's turn", playerID)
+	}
+
+	if !player.IsAlive {
+		return nil, fmt.Errorf("player %s has been eliminated", playerID)
+	}
+
+	if action < Income || action > Examine {
+		return nil, fmt.Errorf("unknown action: %d", action)
+	}
+
+	if !g.Rules.ActionEnabled(action) {
+		return nil, fmt.Errorf("%s is not available under the game's rules", action)
+	}
+
+	if g.Rules.MustCoup(player) && action != Coup {
+		return nil, fmt.Errorf("player %s has %d coins and must coup", playerID, player.Coins)
+	}
+
+	if cost := g.actionCost(action, targetID); player.Coins < cost {
+		return nil, fmt.Errorf("insufficient coins for %s: has %d, needs %d", action, player.Coins, cost)
+	}
+
+	if !action.AcceptsTarget() {
+		if targetID != "" {
+			return nil, fmt.Errorf("%s does not take a target", action)
+		}
+		return player, nil
+	}
+
+	if targetID == "" {
+		if !action.RequiresTarget() {
+			return player, nil
+		}
+		return nil, fmt.Errorf("%s requires a target", action)
+	}
+
+	if targetID == playerID {
+		return nil, fmt.Errorf("player cannot target themselves")
+	}
+
+	target, exists := g.Players[targetID]
+	if !exists {
+		return nil, fmt.Errorf("target player with ID %s not found", targetID)
+	}
+
+	if !target.IsAlive {
+		return nil, fmt.Errorf("target player %s has been eliminated", targetID)
+	}
+
+	if action.RequiresTarget() && g.sameFactionProtected(player, target) {
+		return nil, fmt.Errorf("player %s cannot target %s: both are %s", playerID, targetID, player.Faction)
+	}
+
+	return player, nil
+}
+
+// resolveAction applies the effect of an already paid action and ends the turn, waiting
+// first for the target to pick a lost card or for the actor to pick exchanged cards
+func (g *Game) resolveAction(player *Player, action ActionType, target *Player) error {
+	switch action {
+	case Income, ForeignAid, Tax:
+		g.takeFromTreasury(player, g.Rules.Reward(action))
+	case Coup, Assassinate:
+		return g.requireInfluenceLoss(target, afterLossFinish)
+	case Steal:
+		g.transferCoins(target, player, g.Rules.Reward(action))
+	case Exchange:
+		return g.startExchange(player)
+	case Examine:
+		return g.startExamination(player, target)
+	case Convert:
+		if target != nil {
+			g.convert(target)
+		} else {
+			g.convert(player)
+		}
+	case Embezzle:
+		g.takeReserve(player)
+	}
+
+	return g.finishTurn()
+}
+
+// finishTurn clears the pending action and hands the turn to the next living player
+func (g *Game) finishTurn() error {
+	g.PendingAction = nil
+	if err := g.transition(PhaseAction); err != nil {
+		return err
+	}
+
+	if len(g.timePenalties) > 0 {
+		return g.applyTimePenalty()
+	}
+
+	g.NextTurn()
+	return nil
+}
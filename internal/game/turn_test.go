@@ -0,0 +1,223 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+)
+
+// newStartedGame creates a started game with the given number of players (p0, p1, ...)
+func newStartedGame(t *testing.T, playerCount int) *Game {
+	t.Helper()
+
+	game := NewGame("test")
+	for i := 0; i < playerCount; i++ {
+		player := NewPlayer(fmt.Sprintf("p%d", i), fmt.Sprintf("Player %d", i))
+		if err := game.AddPlayer(player); err != nil {
+			t.Fatalf("AddPlayer() error = %v", err)
+		}
+	}
+
+	if err := game.StartGame(); err != nil {
+		t.Fatalf("StartGame() error = %v", err)
+	}
+
+	return game
+}
+
+// passAll makes every player pass in every response phase until the pending action
+// resolves or waits for an influence loss or exchange
+func passAll(t *testing.T, game *Game) {
+	t.Helper()
+
+	for game.Phase == PhaseChallenge || game.Phase == PhaseBlock || game.Phase == PhaseBlockChallenge {
+		phase := game.Phase
+		for _, id := range game.PlayerOrder {
+			if game.Phase != phase {
+				break
+			}
+			if !game.Players[id].IsAlive || !game.canRespond(game.PendingAction, id) || game.PendingAction.Passed[id] {
+				continue
+			}
+
+			var err error
+			if phase == PhaseBlock {
+				err = game.PassBlock(id)
+			} else {
+				err = game.PassChallenge(id)
+			}
+			if err != nil {
+				t.Fatalf("passing %s phase for %s error = %v", phase, id, err)
+			}
+		}
+	}
+}
+
+// TDD: Test basic coin actions
+func TestGame_PerformAction_CoinActions(t *testing.T) {
+	tests := []struct {
+		action   ActionType
+		expected int
+	}{
+		{Income, 3},
+		{ForeignAid, 4},
+		{Tax, 5},
+	}
+
+	for _, test := range tests {
+		game := newStartedGame(t, 3)
+
+		if err := game.PerformAction("p0", test.action, ""); err != nil {
+			t.Errorf("PerformAction(%v) error = %v, want nil", test.action, err)
+			continue
+		}
+		passAll(t, game)
+
+		if coins := game.Players["p0"].Coins; coins != test.expected {
+			t.Errorf("PerformAction(%v) coins = %v, want %v", test.action, coins, test.expected)
+		}
+
+		if current := game.GetCurrentPlayer(); current.ID != "p1" {
+			t.Errorf("PerformAction(%v) next player = %v, want p1", test.action, current.ID)
+		}
+	}
+}
+
+// TDD: Test turn order is enforced
+func TestGame_PerformAction_NotYourTurn(t *testing.T) {
+	game := newStartedGame(t, 3)
+
+	if err := game.PerformAction("p1", Income, ""); err == nil {
+		t.Error("PerformAction() should return error when it is not the player's turn")
+	}
+
+	if err := game.PerformAction("unknown", Income, ""); err == nil {
+		t.Error("PerformAction() should return error for unknown player")
+	}
+}
+
+// TDD: Test action validation
+func TestGame_PerformAction_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(g *Game)
+		action   ActionType
+		targetID string
+	}{
+		{"coup without coins", nil, Coup, "p1"},
+		{"assassinate without coins", nil, Assassinate, "p1"},
+		{"steal without target", nil, Steal, ""},
+		{"steal from self", nil, Steal, "p0"},
+		{"steal from unknown", nil, Steal, "p9"},
+		{"income with target", nil, Income, "p1"},
+		{"unknown action", nil, ActionType(99), ""},
+		{"steal from eliminated", func(g *Game) { g.Players["p1"].IsAlive = false }, Steal, "p1"},
+		{"tax with 10 coins", func(g *Game) { g.Players["p0"].Coins = 10 }, Tax, ""},
+	}
+
+	for _, test := range tests {
+		game := newStartedGame(t, 3)
+		if test.setup != nil {
+			test.setup(game)
+		}
+
+		if err := game.PerformAction("p0", test.action, test.targetID); err == nil {
+			t.Errorf("PerformAction() %s should return error", test.name)
+		}
+
+		if current := game.GetCurrentPlayer(); current.ID != "p0" {
+			t.Errorf("PerformAction() %s should not advance the turn", test.name)
+		}
+	}
+}
+
+// TDD: Test coup costs 7 coins and removes an influence
+func TestGame_PerformAction_Coup(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p0"].Coins = 7
+
+	if err := game.PerformAction("p0", Coup, "p1"); err != nil {
+		t.Fatalf("PerformAction(Coup) error = %v, want nil", err)
+	}
+	chooseLoss(t, game, "p1")
+
+	if coins := game.Players["p0"].Coins; coins != 0 {
+		t.Errorf("Coins after Coup = %v, want 0", coins)
+	}
+
+	if cards := len(game.Players["p1"].Cards); cards != 1 {
+		t.Errorf("Target cards after Coup = %v, want 1", cards)
+	}
+
+	if len(game.DiscardPile) != 1 {
+		t.Errorf("DiscardPile length = %v, want 1", len(game.DiscardPile))
+	}
+}
+
+// TDD: Test forced coup at 10 coins
+func TestGame_PerformAction_ForcedCoup(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p0"].Coins = 10
+
+	if err := game.PerformAction("p0", Coup, "p2"); err != nil {
+		t.Errorf("PerformAction(Coup) error = %v, want nil", err)
+	}
+}
+
+// TDD: Test assassination costs 3 coins
+func TestGame_PerformAction_Assassinate(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p0"].Coins = 3
+
+	if err := game.PerformAction("p0", Assassinate, "p1"); err != nil {
+		t.Fatalf("PerformAction(Assassinate) error = %v, want nil", err)
+	}
+	passAll(t, game)
+	chooseLoss(t, game, "p1")
+
+	if coins := game.Players["p0"].Coins; coins != 0 {
+		t.Errorf("Coins after Assassinate = %v, want 0", coins)
+	}
+
+	if cards := len(game.Players["p1"].Cards); cards != 1 {
+		t.Errorf("Target cards after Assassinate = %v, want 1", cards)
+	}
+}
+
+// TDD: Test steal never takes more than the target has
+func TestGame_PerformAction_Steal(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p1"].Coins = 1
+
+	if err := game.PerformAction("p0", Steal, "p1"); err != nil {
+		t.Fatalf("PerformAction(Steal) error = %v, want nil", err)
+	}
+	passAll(t, game)
+
+	if coins := game.Players["p0"].Coins; coins != 3 {
+		t.Errorf("Actor coins after Steal = %v, want 3", coins)
+	}
+
+	if coins := game.Players["p1"].Coins; coins != 0 {
+		t.Errorf("Target coins after Steal = %v, want 0", coins)
+	}
+}
+
+// TDD: Test eliminating the last opponent ends the game
+func TestGame_PerformAction_EndsGame(t *testing.T) {
+	game := newStartedGame(t, 3)
+	game.Players["p2"].IsAlive = false
+	game.Players["p1"].Cards = game.Players["p1"].Cards[:1]
+	game.Players["p0"].Coins = 7
+
+	if err := game.PerformAction("p0", Coup, "p1"); err != nil {
+		t.Fatalf("PerformAction(Coup) error = %v, want nil", err)
+	}
+
+	if game.State != Finished {
+		t.Errorf("State = %v, want Finished", game.State)
+	}
+
+	if game.Winner == nil || game.Winner.ID != "p0" {
+		t.Errorf("Winner = %v, want p0", game.Winner)
+	}
+}
@@ -0,0 +1,188 @@
+package lobby
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// inviteNonceSize is the number of random bytes identifying an invite
+const inviteNonceSize = 16
+
+// Credentials are what a player presents to join a private room: its passcode, or an
+// invite token generated by the host
+type Credentials struct {
+	Passcode string `json:"passcode,omitempty"`
+	Invite   string `json:"invite,omitempty"`
+}
+
+// JoinError explains why a player could not join a room. It carries a translation key so
+// the rejection can be shown in the player's language.
+type JoinError struct {
+	ID      string
+	Data    map[string]interface{}
+	message string
+}
+
+// newJoinError creates a join rejection for a room
+func newJoinError(id, message, code string) *JoinError {
+	return &JoinError{
+		ID:      id,
+		Data:    map[string]interface{}{"Code": code},
+		message: message,
+	}
+}
+
+// Error returns the rejection in English
+func (je *JoinError) Error() string {
+	return je.message
+}
+
+// MessageID returns the translation key describing the rejection
+func (je *JoinError) MessageID() string {
+	return je.ID
+}
+
+// MessageData returns the template data for the rejection translation
+func (je *JoinError) MessageData() map[string]interface{} {
+	return je.Data
+}
+
+// SetPrivate lets the host make the room private. Players then need the passcode, or an
+// invite when the passcode is empty.
+func (r *Room) SetPrivate(hostID, passcode string) error {
+	if err := r.requireHost(hostID, "make the room private"); err != nil {
+		return err
+	}
+
+	if err := r.ensureInviteSecret(); err != nil {
+		return err
+	}
+
+	r.Private = true
+	r.passcode = passcode
+	return nil
+}
+
+// SetPublic lets the host open the room to anyone with its code. Invites handed out
+// before are revoked.
+func (r *Room) SetPublic(hostID string) error {
+	if err := r.requireHost(hostID, "make the room public"); err != nil {
+		return err
+	}
+
+	r.Private = false
+	r.passcode = ""
+	r.inviteSecret = nil
+	r.usedInvites = nil
+	return nil
+}
+
+// CreateInvite lets the host generate a signed invite that lets one player into the
+// private room
+func (r *Room) CreateInvite(hostID string) (string, error) {
+	if err := r.requireHost(hostID, "invite players"); err != nil {
+		return "", err
+	}
+
+	if !r.Private {
+		return "", fmt.Errorf("room %s is public and needs no invite", r.Code)
+	}
+
+	nonce := make([]byte, inviteNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate invite: %v", err)
+	}
+
+	payload := r.Code + ":" + hex.EncodeToString(nonce)
+	return encodeToken(payload) + "." + encodeToken(string(r.signInvite(payload))), nil
+}
+
+// checkAccess checks the credentials presented to join a private room, returning the
+// nonce of the invite to spend if one was used
+func (r *Room) checkAccess(credentials Credentials) (string, error) {
+	if !r.Private {
+		return "", nil
+	}
+
+	if credentials.Invite != "" {
+		return r.verifyInvite(credentials.Invite)
+	}
+
+	if credentials.Passcode == "" || r.passcode == "" {
+		return "", newJoinError("join_room_private", fmt.Sprintf("room %s is private", r.Code), r.Code)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(credentials.Passcode), []byte(r.passcode)) != 1 {
+		return "", newJoinError("join_wrong_passcode", fmt.Sprintf("wrong passcode for room %s", r.Code), r.Code)
+	}
+	return "", nil
+}
+
+// verifyInvite checks an invite's signature and that it is meant for this room and unused
+func (r *Room) verifyInvite(token string) (string, error) {
+	invalid := newJoinError("join_invalid_invite", fmt.Sprintf("invalid invite for room %s", r.Code), r.Code)
+
+	encodedPayload, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return "", invalid
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return "", invalid
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return "", invalid
+	}
+
+	if !hmac.Equal(signature, r.signInvite(string(payload))) {
+		return "", invalid
+	}
+
+	code, nonce, found := strings.Cut(string(payload), ":")
+	if !found || code != r.Code {
+		return "", invalid
+	}
+
+	if r.usedInvites[nonce] {
+		return "", newJoinError("join_invite_used", fmt.Sprintf("invite for room %s has already been used", r.Code), r.Code)
+	}
+	return nonce, nil
+}
+
+// ensureInviteSecret creates the key signing the room's invites
+func (r *Room) ensureInviteSecret() error {
+	if r.usedInvites == nil {
+		r.usedInvites = make(map[string]bool)
+	}
+
+	if r.inviteSecret != nil {
+		return nil
+	}
+
+	secret := make([]byte, sha256.Size)
+	if _, err := rand.Read(secret); err != nil {
+		return fmt.Errorf("failed to generate invite key: %v", err)
+	}
+	r.inviteSecret = secret
+	return nil
+}
+
+// signInvite returns the HMAC-SHA256 signature of an invite payload
+func (r *Room) signInvite(payload string) []byte {
+	mac := hmac.New(sha256.New, r.inviteSecret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+// encodeToken encodes part of an invite token for use in URLs
+func encodeToken(data string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(data))
+}
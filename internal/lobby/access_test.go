@@ -0,0 +1,181 @@
+package lobby
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/leoferamos/coup-game/internal/i18n"
+)
+
+// newPrivateRoom creates a room whose host made it private with the given passcode
+func newPrivateRoom(t *testing.T, passcode string) *Room {
+	t.Helper()
+
+	room := newFilledRoom(t, 1)
+	if err := room.SetPrivate("player-1", passcode); err != nil {
+		t.Fatalf("SetPrivate() error = %v", err)
+	}
+	return room
+}
+
+// joinErrorID returns the translation key of a join rejection
+func joinErrorID(t *testing.T, err error) string {
+	t.Helper()
+
+	var joinErr *JoinError
+	if !errors.As(err, &joinErr) {
+		t.Fatalf("error = %v, want a *JoinError", err)
+	}
+	return joinErr.MessageID()
+}
+
+// TDD: Test private rooms need their passcode
+func TestRoom_Passcode(t *testing.T) {
+	room := newPrivateRoom(t, "s3cret")
+
+	if id := joinErrorID(t, room.AddPlayer(Player{ID: "player-2"})); id != "join_room_private" {
+		t.Errorf("AddPlayer() rejection = %s, want join_room_private", id)
+	}
+	if id := joinErrorID(t, room.Join(Player{ID: "player-2"}, Credentials{Passcode: "guess"})); id != "join_wrong_passcode" {
+		t.Errorf("Join() rejection = %s, want join_wrong_passcode", id)
+	}
+	if err := room.Join(Player{ID: "player-2"}, Credentials{Passcode: "s3cret"}); err != nil {
+		t.Errorf("Join() with the passcode error = %v", err)
+	}
+
+	if err := room.SetPrivate("player-2", "other"); err == nil {
+		t.Error("SetPrivate() by a guest should fail")
+	}
+
+	room.SetPublic("player-1")
+	if err := room.AddPlayer(Player{ID: "player-3"}); err != nil {
+		t.Errorf("AddPlayer() to a public room error = %v", err)
+	}
+}
+
+// TDD: Test invites let one player into a private room
+func TestRoom_Invite(t *testing.T) {
+	room := newPrivateRoom(t, "")
+
+	if _, err := room.CreateInvite("player-2"); err == nil {
+		t.Error("CreateInvite() by someone other than the host should fail")
+	}
+
+	invite, err := room.CreateInvite("player-1")
+	if err != nil {
+		t.Fatalf("CreateInvite() error = %v", err)
+	}
+
+	if id := joinErrorID(t, room.Join(Player{ID: "player-2"}, Credentials{Passcode: "anything"})); id != "join_room_private" {
+		t.Errorf("Join() to an invite-only room rejection = %s, want join_room_private", id)
+	}
+	if err := room.Join(Player{ID: "player-2"}, Credentials{Invite: invite}); err != nil {
+		t.Fatalf("Join() with an invite error = %v", err)
+	}
+	if id := joinErrorID(t, room.Join(Player{ID: "player-3"}, Credentials{Invite: invite})); id != "join_invite_used" {
+		t.Errorf("Join() with a spent invite rejection = %s, want join_invite_used", id)
+	}
+}
+
+// TDD: Test forged, foreign and revoked invites are rejected
+func TestRoom_Invite_Invalid(t *testing.T) {
+	room := newPrivateRoom(t, "")
+	other := newPrivateRoom(t, "")
+	other.Code = room.Code + "x"
+
+	foreign, _ := other.CreateInvite("player-1")
+	invite, _ := room.CreateInvite("player-1")
+	payload, _, _ := strings.Cut(invite, ".")
+
+	for name, token := range map[string]string{
+		"garbage":   "not-a-token",
+		"foreign":   foreign,
+		"forged":    payload + "." + encodeToken("signature"),
+		"truncated": payload + ".",
+	} {
+		if id := joinErrorID(t, room.Join(Player{ID: "player-2"}, Credentials{Invite: token})); id != "join_invalid_invite" {
+			t.Errorf("Join() with a %s invite rejection = %s, want join_invalid_invite", name, id)
+		}
+	}
+
+	// Making the room public and private again revokes earlier invites
+	room.SetPublic("player-1")
+	room.SetPrivate("player-1", "")
+	if id := joinErrorID(t, room.Join(Player{ID: "player-2"}, Credentials{Invite: invite})); id != "join_invalid_invite" {
+		t.Errorf("Join() with a revoked invite rejection = %s, want join_invalid_invite", id)
+	}
+}
+
+// TDD: Test an invite is not spent when the join fails for another reason
+func TestRoom_Invite_NotSpent(t *testing.T) {
+	room := newPrivateRoom(t, "")
+	invite, _ := room.CreateInvite("player-1")
+
+	room.SetLocked("player-1", true)
+	if id := joinErrorID(t, room.Join(Player{ID: "player-2"}, Credentials{Invite: invite})); id != "join_room_locked" {
+		t.Errorf("Join() to a locked room rejection = %s, want join_room_locked", id)
+	}
+
+	room.SetLocked("player-1", false)
+	if err := room.Join(Player{ID: "player-2"}, Credentials{Invite: invite}); err != nil {
+		t.Errorf("Join() after unlocking error = %v", err)
+	}
+}
+
+// TDD: Test join rejections translate into the player's language
+func TestJoinError_Localized(t *testing.T) {
+	locales, _ := filepath.Abs(filepath.Join("..", "i18n", "locales"))
+	bundle, err := i18n.NewBundle(locales)
+	if err != nil {
+		t.Fatalf("NewBundle() error = %v", err)
+	}
+
+	room := newPrivateRoom(t, "s3cret")
+	var joinErr *JoinError
+	errors.As(room.Join(Player{ID: "player-2"}, Credentials{Passcode: "guess"}), &joinErr)
+
+	message, err := bundle.GetMessageWithData("pt", joinErr.MessageID(), joinErr.MessageData())
+	if err != nil {
+		t.Fatalf("GetMessageWithData() error = %v", err)
+	}
+	if want := "Senha incorreta para a sala " + room.Code + "."; message != want {
+		t.Errorf("message = %q, want %q", message, want)
+	}
+
+	room.MaxPlayers = 1
+	room.SetPublic("player-1")
+	errors.As(room.AddPlayer(Player{ID: "player-3"}), &joinErr)
+	message, _ = bundle.GetMessageWithData("en", joinErr.MessageID(), joinErr.MessageData())
+	if !strings.Contains(message, "maximum 1 players") {
+		t.Errorf("message = %q, want the room size", message)
+	}
+}
+
+// TDD: Test the registry joins private rooms with credentials
+func TestRegistry_JoinRoomWith(t *testing.T) {
+	registry, _ := newTestRegistry(DefaultRoomTTL)
+	room, _ := registry.CreateRoom()
+	registry.JoinRoom(room.Code, Player{ID: "host"})
+
+	var invite string
+	registry.UpdateRoom(room.Code, func(room *Room) error {
+		if err := room.SetPrivate("host", ""); err != nil {
+			return err
+		}
+		var err error
+		invite, err = room.CreateInvite("host")
+		return err
+	})
+
+	if err := registry.JoinRoom(room.Code, Player{ID: "guest"}); err == nil {
+		t.Error("JoinRoom() without credentials should fail for a private room")
+	}
+	if err := registry.JoinRoomWith(room.Code, Player{ID: "guest"}, Credentials{Invite: invite}); err != nil {
+		t.Fatalf("JoinRoomWith() error = %v", err)
+	}
+	if err := registry.JoinRoomWith(room.Code, Player{ID: "other"}, Credentials{Invite: invite}); err == nil {
+		t.Error("JoinRoomWith() should not accept an invite twice")
+	}
+}
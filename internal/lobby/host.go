@@ -0,0 +1,108 @@
+package lobby
+
+import (
+	"fmt"
+
+	"github.com/leoferamos/coup-game/internal/game"
+)
+
+// RoomSettings holds the options the host may change between games
+type RoomSettings struct {
+	MaxPlayers int  `json:"maxPlayers"`
+	HeadToHead bool `json:"headToHead"`
+}
+
+// requireHost checks that the player asking for a host-only operation is the host
+func (r *Room) requireHost(playerID, operation string) error {
+	if playerID == "" || playerID != r.HostID {
+		return fmt.Errorf("only the host can %s", operation)
+	}
+	return nil
+}
+
+// reassignHost hands the host role to the player who has been in the room the longest,
+// or leaves the room without a host when it is empty
+func (r *Room) reassignHost() {
+	r.HostID = ""
+	if len(r.Players) > 0 {
+		r.HostID = r.Players[0].ID
+	}
+}
+
+// hasPlayer reports whether a player is in the room
+func (r *Room) hasPlayer(playerID string) bool {
+	for _, player := range r.Players {
+		if player.ID == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// Kick lets the host remove another player from the room
+func (r *Room) Kick(hostID, playerID string) error {
+	if err := r.requireHost(hostID, "kick players"); err != nil {
+		return err
+	}
+
+	if playerID == hostID {
+		return fmt.Errorf("the host cannot kick themselves")
+	}
+
+	return r.RemovePlayer(playerID)
+}
+
+// SetLocked lets the host stop or allow new players joining the room
+func (r *Room) SetLocked(hostID string, locked bool) error {
+	if err := r.requireHost(hostID, "lock the room"); err != nil {
+		return err
+	}
+
+	r.Locked = locked
+	return nil
+}
+
+// TransferHost lets the host hand the host role to another player in the room
+func (r *Room) TransferHost(hostID, playerID string) error {
+	if err := r.requireHost(hostID, "transfer the host role"); err != nil {
+		return err
+	}
+
+	if !r.hasPlayer(playerID) {
+		return fmt.Errorf("player with ID %s not found in room", playerID)
+	}
+
+	r.HostID = playerID
+	return nil
+}
+
+// ChangeSettings lets the host change the room settings while no game is in progress.
+// A zero MaxPlayers keeps the largest table the mode allows.
+func (r *Room) ChangeSettings(hostID string, settings RoomSettings) error {
+	if err := r.requireHost(hostID, "change the settings"); err != nil {
+		return err
+	}
+
+	lowest, limit := 3, game.MaxTablePlayers
+	if settings.HeadToHead {
+		lowest, limit = 2, 2
+	}
+	if len(r.Players) > lowest {
+		lowest = len(r.Players)
+	}
+
+	maxPlayers := settings.MaxPlayers
+	if maxPlayers == 0 {
+		maxPlayers = limit
+	}
+	if maxPlayers < lowest || maxPlayers > limit {
+		return fmt.Errorf("max players must be between %d and %d, got %d", lowest, limit, maxPlayers)
+	}
+
+	if err := r.SetHeadToHead(settings.HeadToHead); err != nil {
+		return err
+	}
+
+	r.MaxPlayers = maxPlayers
+	return nil
+}
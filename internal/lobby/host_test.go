@@ -0,0 +1,114 @@
+package lobby
+
+import "testing"
+
+// TDD: Test the first player to join becomes the host and the role passes on when they leave
+func TestRoom_Host(t *testing.T) {
+	room := newFilledRoom(t, 3)
+
+	if room.HostID != "player-1" {
+		t.Errorf("HostID = %q, want player-1", room.HostID)
+	}
+
+	room.RemovePlayer("player-2")
+	if room.HostID != "player-1" {
+		t.Errorf("HostID = %q after a guest left, want player-1", room.HostID)
+	}
+
+	room.RemovePlayer("player-1")
+	if room.HostID != "player-3" {
+		t.Errorf("HostID = %q after the host left, want player-3", room.HostID)
+	}
+
+	room.RemovePlayer("player-3")
+	if room.HostID != "" {
+		t.Errorf("HostID = %q in an empty room, want none", room.HostID)
+	}
+
+	room.AddPlayer(Player{ID: "player-4", Name: "Player4"})
+	if room.HostID != "player-4" {
+		t.Errorf("HostID = %q, want player-4", room.HostID)
+	}
+}
+
+// TDD: Test host-only commands are refused to other players
+func TestRoom_HostOnly(t *testing.T) {
+	room := newFilledRoom(t, 3)
+
+	if err := room.Kick("player-2", "player-3"); err == nil {
+		t.Error("Kick() by a guest should fail")
+	}
+	if err := room.SetLocked("player-2", true); err == nil {
+		t.Error("SetLocked() by a guest should fail")
+	}
+	if err := room.TransferHost("player-2", "player-2"); err == nil {
+		t.Error("TransferHost() by a guest should fail")
+	}
+	if err := room.ChangeSettings("player-2", RoomSettings{}); err == nil {
+		t.Error("ChangeSettings() by a guest should fail")
+	}
+	if _, err := room.StartGame("player-2"); err == nil {
+		t.Error("StartGame() by a guest should fail")
+	}
+}
+
+// TDD: Test the host kicks players, locks the room and transfers the role
+func TestRoom_HostControls(t *testing.T) {
+	room := newFilledRoom(t, 3)
+
+	if err := room.Kick("player-1", "player-1"); err == nil {
+		t.Error("Kick() of the host by themselves should fail")
+	}
+	if err := room.Kick("player-1", "player-3"); err != nil {
+		t.Fatalf("Kick() error = %v", err)
+	}
+	if len(room.Players) != 2 {
+		t.Errorf("Room has %d players after a kick, want 2", len(room.Players))
+	}
+
+	room.SetLocked("player-1", true)
+	if err := room.AddPlayer(Player{ID: "player-5", Name: "Player5"}); err == nil {
+		t.Error("AddPlayer() should fail when the room is locked")
+	}
+	room.SetLocked("player-1", false)
+	if err := room.AddPlayer(Player{ID: "player-5", Name: "Player5"}); err != nil {
+		t.Errorf("AddPlayer() after unlocking error = %v", err)
+	}
+
+	if err := room.TransferHost("player-1", "stranger"); err == nil {
+		t.Error("TransferHost() to a player outside the room should fail")
+	}
+	if err := room.TransferHost("player-1", "player-2"); err != nil {
+		t.Fatalf("TransferHost() error = %v", err)
+	}
+	if room.HostID != "player-2" {
+		t.Errorf("HostID = %q, want player-2", room.HostID)
+	}
+}
+
+// TDD: Test the host changes the room settings within the table limits
+func TestRoom_ChangeSettings(t *testing.T) {
+	room := newFilledRoom(t, 3)
+
+	if err := room.ChangeSettings("player-1", RoomSettings{MaxPlayers: 4}); err != nil {
+		t.Fatalf("ChangeSettings() error = %v", err)
+	}
+	if room.MaxPlayers != 4 {
+		t.Errorf("MaxPlayers = %d, want 4", room.MaxPlayers)
+	}
+
+	if err := room.ChangeSettings("player-1", RoomSettings{MaxPlayers: 2}); err == nil {
+		t.Error("ChangeSettings() below the players in the room should fail")
+	}
+	if err := room.ChangeSettings("player-1", RoomSettings{HeadToHead: true}); err == nil {
+		t.Error("ChangeSettings() to head-to-head with 3 players should fail")
+	}
+
+	room.Kick("player-1", "player-3")
+	if err := room.ChangeSettings("player-1", RoomSettings{HeadToHead: true}); err != nil {
+		t.Fatalf("ChangeSettings() error = %v", err)
+	}
+	if !room.HeadToHead || room.MaxPlayers != 2 {
+		t.Errorf("Room = head-to-head %v with %d seats, want true with 2", room.HeadToHead, room.MaxPlayers)
+	}
+}
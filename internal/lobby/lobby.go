@@ -2,8 +2,9 @@ package lobby
 
 import (
 	"fmt"
-	"math/rand"
 	"time"
+
+	"github.com/leoferamos/coup-game/internal/game"
 )
 
 // Player represents a player in the game
@@ -14,45 +15,108 @@ type Player struct {
 
 // Room represents a game room
 type Room struct {
-	Code       string    `json:"code"`
-	Players    []Player  `json:"players"`
-	MaxPlayers int       `json:"maxPlayers"`
-	CreatedAt  time.Time `json:"createdAt"`
+	Code        string     `json:"code"`
+	Players     []Player   `json:"players"`
+	MaxPlayers  int        `json:"maxPlayers"`
+	HeadToHead  bool       `json:"headToHead"`
+	HostID      string     `json:"hostId"`
+	Locked      bool       `json:"locked"`
+	Private     bool       `json:"private"`
+	Status      RoomStatus `json:"status"`
+	GamesPlayed int        `json:"gamesPlayed"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	LastActive  time.Time  `json:"lastActive"`
+
+	// Ready marks the players who are ready for the next game
+	Ready map[string]bool `json:"ready"`
+	// Countdown is how long the room counts down once every player is ready
+	Countdown time.Duration `json:"countdown"`
+	// CountdownEndsAt is when the game starts, set while the room is starting
+	CountdownEndsAt *time.Time `json:"countdownEndsAt,omitempty"`
+
+	// Game is the match being played in the room, nil while the room is waiting. While
+	// the room is starting, the game counts down in the game.Starting state.
+	Game *game.Game `json:"-"`
+
+	passcode     string          // Passcode of a private room, empty for invite-only rooms
+	inviteSecret []byte          // Key signing the room's invite tokens
+	usedInvites  map[string]bool // Nonces of invites already spent
 }
 
 // CreateRoom creates a new room with a 4-digit numeric code
 func CreateRoom() *Room {
+	return NewRoom(game.NewRandom(time.Now().UnixNano()), game.SystemClock{})
+}
+
+// NewRoom creates a new room whose code and creation time come from the given sources,
+// so rooms can be reproduced in simulations and tests
+func NewRoom(rng *game.Random, clock game.Clock) *Room {
+	now := clock.Now()
 	return &Room{
-		Code:       generateRoomCode(),
+		Code:       generateRoomCode(rng),
 		Players:    make([]Player, 0),
-		MaxPlayers: 10,
-		CreatedAt:  time.Now(),
+		MaxPlayers: game.MaxTablePlayers,
+		CreatedAt:  now,
+		LastActive: now,
+		Ready:      make(map[string]bool),
+		Countdown:  DefaultStartCountdown,
 	}
 }
 
+// RoomCodeCount is the number of distinct 4-digit room codes
+const RoomCodeCount = 10000
+
 // generateRoomCode generates a 4-digit numeric code
-func generateRoomCode() string {
-	rand.Seed(time.Now().UnixNano())
-	code := rand.Intn(10000)         // 0-9999
+func generateRoomCode(rng *game.Random) string {
+	code := rng.Intn(RoomCodeCount)  // 0-9999
 	return fmt.Sprintf("%04d", code) // Ensure 4 digits with leading zeros
 }
 
-// AddPlayer adds a player to the room
+// AddPlayer adds a player to a public room
 func (r *Room) AddPlayer(player Player) error {
+	return r.Join(player, Credentials{})
+}
+
+// Join adds a player to the room, checking the credentials of a private room. Rejections
+// are *JoinError values that can be shown to the player in their language.
+func (r *Room) Join(player Player, credentials Credentials) error {
+	invite, err := r.checkAccess(credentials)
+	if err != nil {
+		return err
+	}
+
+	if r.Status != RoomWaiting {
+		return newJoinError("join_room_busy", fmt.Sprintf("cannot join room %s while it is %s", r.Code, r.Status), r.Code)
+	}
+
+	if r.Locked {
+		return newJoinError("join_room_locked", fmt.Sprintf("room %s is locked", r.Code), r.Code)
+	}
+
 	// Check if room is full
 	if len(r.Players) >= r.MaxPlayers {
-		return fmt.Errorf("room is full, maximum %d players allowed", r.MaxPlayers)
+		err := newJoinError("join_room_full", fmt.Sprintf("room is full, maximum %d players allowed", r.MaxPlayers), r.Code)
+		err.Data["Max"] = r.MaxPlayers
+		return err
 	}
 
 	// Check for duplicate player ID
 	for _, existingPlayer := range r.Players {
 		if existingPlayer.ID == player.ID {
-			return fmt.Errorf("player with ID %s already exists in room", player.ID)
+			return newJoinError("join_duplicate_player", fmt.Sprintf("player with ID %s already exists in room", player.ID), r.Code)
 		}
 	}
 
-	// Add player to room
+	// Invites are only spent once the player is in
+	if invite != "" {
+		r.usedInvites[invite] = true
+	}
+
+	// Add player to room; the first one in becomes the host
 	r.Players = append(r.Players, player)
+	if r.HostID == "" {
+		r.HostID = player.ID
+	}
 	return nil
 }
 
@@ -62,6 +126,17 @@ func (r *Room) RemovePlayer(playerID string) error {
 		if player.ID == playerID {
 			// Remove player from slice
 			r.Players = append(r.Players[:i], r.Players[i+1:]...)
+			delete(r.Ready, playerID)
+			r.CancelCountdown()
+
+			if r.HostID == playerID {
+				r.reassignHost()
+			}
+
+			if r.Game != nil {
+				// The player stays seated in the game, marked as gone
+				return r.Game.RemovePlayer(playerID)
+			}
 			return nil
 		}
 	}
@@ -69,8 +144,31 @@ func (r *Room) RemovePlayer(playerID string) error {
 	return fmt.Errorf("player with ID %s not found in room", playerID)
 }
 
+// SetHeadToHead switches the room between the regular game and the two-player rules
+func (r *Room) SetHeadToHead(enabled bool) error {
+	if r.Status != RoomWaiting {
+		return fmt.Errorf("cannot change the rules of room %s while a game is in progress", r.Code)
+	}
+
+	if enabled && len(r.Players) > 2 {
+		return fmt.Errorf("head-to-head rooms allow 2 players, room has %d", len(r.Players))
+	}
+
+	r.HeadToHead = enabled
+	r.MaxPlayers = game.MaxTablePlayers
+	if enabled {
+		r.MaxPlayers = 2
+	}
+	return nil
+}
+
 // IsReadyToStart checks if the room has enough players to start a game
 func (r *Room) IsReadyToStart() bool {
+	// Head-to-head games need exactly 2 players
+	if r.HeadToHead {
+		return len(r.Players) == 2
+	}
+
 	// Coup requires at least 3 players
 	return len(r.Players) >= 3
 }
@@ -84,14 +182,5 @@ func CalculateDeckSize(playerCount int) int {
 
 // CalculateCardsPerInfluence calculates cards per influence based on player count
 func CalculateCardsPerInfluence(playerCount int) int {
-	if playerCount >= 3 && playerCount <= 6 {
-		return 3 // 3 of each for 3-6 players
-	} else if playerCount >= 7 && playerCount <= 8 {
-		return 4 // 4 of each for 7-8 players
-	} else if playerCount >= 9 && playerCount <= 10 {
-		return 5 // 5 of each for 9-10 players
-	}
-
-	// Default to 3 for invalid player counts
-	return 3
+	return game.CopiesPerCharacter(playerCount)
 }
@@ -3,6 +3,9 @@ package lobby
 import (
 	"fmt"
 	"testing"
+	"time"
+
+	"github.com/leoferamos/coup-game/internal/game"
 )
 
 // TDD: Test room creation with 4-digit numeric code
@@ -210,3 +213,48 @@ func TestDeckSizeCalculation(t *testing.T) {
 		})
 	}
 }
+
+// TDD: Test rooms created from the same seed and clock are identical
+func TestNewRoom_Deterministic(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	first := NewRoom(game.NewRandom(42), game.NewFakeClock(start))
+	second := NewRoom(game.NewRandom(42), game.NewFakeClock(start))
+
+	if first.Code != second.Code {
+		t.Errorf("Room codes = %v and %v, want equal for the same seed", first.Code, second.Code)
+	}
+
+	if !first.CreatedAt.Equal(start) {
+		t.Errorf("CreatedAt = %v, want %v", first.CreatedAt, start)
+	}
+}
+
+// TDD: Test head-to-head rooms start with exactly 2 players
+func TestHeadToHead(t *testing.T) {
+	room := CreateRoom()
+
+	if err := room.SetHeadToHead(true); err != nil {
+		t.Fatalf("SetHeadToHead() error = %v", err)
+	}
+
+	room.AddPlayer(Player{ID: "player-1", Name: "Player1"})
+	if room.IsReadyToStart() {
+		t.Error("Head-to-head room with 1 player should not be ready to start")
+	}
+
+	room.AddPlayer(Player{ID: "player-2", Name: "Player2"})
+	if !room.IsReadyToStart() {
+		t.Error("Head-to-head room with 2 players should be ready to start")
+	}
+
+	if err := room.AddPlayer(Player{ID: "player-3", Name: "Player3"}); err == nil {
+		t.Error("Head-to-head room should not accept a third player")
+	}
+
+	room.SetHeadToHead(false)
+	room.AddPlayer(Player{ID: "player-3", Name: "Player3"})
+	if err := room.SetHeadToHead(true); err == nil {
+		t.Error("SetHeadToHead() with 3 players should fail")
+	}
+}
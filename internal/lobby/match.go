@@ -0,0 +1,112 @@
+package lobby
+
+import (
+	"fmt"
+
+	"github.com/leoferamos/coup-game/internal/game"
+)
+
+// RoomStatus tracks whether a room is gathering players or playing a game
+type RoomStatus int
+
+const (
+	// RoomWaiting means the room is open for players to join before a game
+	RoomWaiting RoomStatus = iota
+	// RoomStarting means every player is ready and the room is counting down to the game
+	RoomStarting
+	// RoomPlaying means the room's players are in a game
+	RoomPlaying
+)
+
+// String returns the string representation of a room status
+func (rs RoomStatus) String() string {
+	switch rs {
+	case RoomWaiting:
+		return "waiting"
+	case RoomStarting:
+		return "starting"
+	case RoomPlaying:
+		return "playing"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText encodes the room status by name
+func (rs RoomStatus) MarshalText() ([]byte, error) {
+	return []byte(rs.String()), nil
+}
+
+// StartGame lets the host start a game with the room's players straight away, without
+// waiting for the ready check. Players are seated in the order they joined. Head-to-head
+// rooms play the two-player rules; opts are applied after them.
+func (r *Room) StartGame(playerID string, opts ...game.Option) (*game.Game, error) {
+	if err := r.requireHost(playerID, "start the game"); err != nil {
+		return nil, err
+	}
+
+	return r.launch(opts...)
+}
+
+// launch deals a game to the room's players and moves the room to playing. A room that
+// is counting down deals the game it created when the countdown began.
+func (r *Room) launch(opts ...game.Option) (*game.Game, error) {
+	if r.Status == RoomPlaying {
+		return nil, fmt.Errorf("room %s is already playing a game", r.Code)
+	}
+
+	g := r.Game
+	if r.Status != RoomStarting || g == nil {
+		var err error
+		if g, err = r.newGame(opts...); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := g.StartGame(); err != nil {
+		return nil, err
+	}
+
+	r.Game = g
+	r.Status = RoomPlaying
+	r.GamesPlayed++
+	r.Ready = make(map[string]bool)
+	r.CountdownEndsAt = nil
+	return g, nil
+}
+
+// newGame seats the room's players, in order, at a new game that has not started yet
+func (r *Room) newGame(opts ...game.Option) (*game.Game, error) {
+	if !r.IsReadyToStart() {
+		return nil, fmt.Errorf("room %s does not have enough players to start", r.Code)
+	}
+
+	if r.HeadToHead {
+		opts = append([]game.Option{game.WithRuleSet(game.TwoPlayerRuleSet())}, opts...)
+	}
+
+	g := game.NewGame(fmt.Sprintf("%s-%d", r.Code, r.GamesPlayed+1), opts...)
+	for _, player := range r.Players {
+		if err := g.AddPlayer(game.NewPlayer(player.ID, player.Name)); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// InGame reports whether the room is playing a game that is not over yet
+func (r *Room) InGame() bool {
+	return r.Status == RoomPlaying && r.Game != nil && r.Game.State != game.Finished
+}
+
+// ResetIfFinished returns the room to waiting, with the same members, once its game is
+// over. It reports whether the room was reset.
+func (r *Room) ResetIfFinished() bool {
+	if r.Game == nil || r.Game.State != game.Finished {
+		return false
+	}
+
+	r.Game = nil
+	r.Status = RoomWaiting
+	return true
+}
@@ -0,0 +1,116 @@
+package lobby
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/leoferamos/coup-game/internal/game"
+)
+
+// newFilledRoom creates a room with the given number of players
+func newFilledRoom(t *testing.T, playerCount int) *Room {
+	t.Helper()
+
+	room := CreateRoom()
+	for i := 1; i <= playerCount; i++ {
+		if err := room.AddPlayer(Player{ID: fmt.Sprintf("player-%d", i), Name: fmt.Sprintf("Player%d", i)}); err != nil {
+			t.Fatalf("AddPlayer() error = %v", err)
+		}
+	}
+	return room
+}
+
+// TDD: Test the host starts a game seated in join order
+func TestRoom_StartGame(t *testing.T) {
+	room := newFilledRoom(t, 3)
+
+	if _, err := room.StartGame("player-2"); err == nil {
+		t.Error("StartGame() by a player who is not the host should fail")
+	}
+
+	g, err := room.StartGame(room.HostID, game.WithSeed(1))
+	if err != nil {
+		t.Fatalf("StartGame() error = %v", err)
+	}
+
+	if room.Game != g || room.Status != RoomPlaying {
+		t.Errorf("Room status = %v, want playing with its game", room.Status)
+	}
+	if g.State != game.Playing {
+		t.Errorf("Game state = %v, want Playing", g.State)
+	}
+	for i, id := range g.PlayerOrder {
+		if id != room.Players[i].ID || g.Players[id].Name != room.Players[i].Name {
+			t.Errorf("Seat %d = %s, want %s", i, id, room.Players[i].ID)
+		}
+	}
+
+	if err := room.AddPlayer(Player{ID: "late", Name: "Late"}); err == nil {
+		t.Error("AddPlayer() should fail while a game is in progress")
+	}
+	if _, err := room.StartGame(room.HostID); err == nil {
+		t.Error("StartGame() should fail while a game is in progress")
+	}
+}
+
+// TDD: Test rooms that are not ready cannot start and head-to-head rooms use two-player rules
+func TestRoom_StartGame_Rules(t *testing.T) {
+	room := newFilledRoom(t, 2)
+	if _, err := room.StartGame(room.HostID); err == nil {
+		t.Error("StartGame() with 2 players should fail outside head-to-head")
+	}
+
+	room.SetHeadToHead(true)
+	g, err := room.StartGame(room.HostID)
+	if err != nil {
+		t.Fatalf("StartGame() error = %v", err)
+	}
+	if !g.Rules.TwoPlayer {
+		t.Error("Head-to-head rooms should play the two-player rules")
+	}
+}
+
+// TDD: Test the room returns to waiting with the same members once the game ends
+func TestRoom_ResetIfFinished(t *testing.T) {
+	room := newFilledRoom(t, 3)
+	g, _ := room.StartGame(room.HostID)
+
+	if room.ResetIfFinished() {
+		t.Error("ResetIfFinished() should not reset a room whose game is running")
+	}
+
+	g.Players["player-2"].IsAlive = false
+	g.Players["player-3"].IsAlive = false
+	g.CheckGameEnd()
+
+	if !room.ResetIfFinished() {
+		t.Fatal("ResetIfFinished() should reset a room whose game is over")
+	}
+	if room.Status != RoomWaiting || room.Game != nil {
+		t.Errorf("Room status = %v, want waiting without a game", room.Status)
+	}
+	if len(room.Players) != 3 {
+		t.Errorf("Room has %d players, want 3", len(room.Players))
+	}
+
+	next, err := room.StartGame(room.HostID)
+	if err != nil {
+		t.Fatalf("StartGame() for a rematch error = %v", err)
+	}
+	if next.ID == g.ID {
+		t.Errorf("Rematch game ID = %s, want a new ID", next.ID)
+	}
+}
+
+// TDD: Test a player leaving mid-game is marked inactive in the game
+func TestRoom_RemovePlayer_InGame(t *testing.T) {
+	room := newFilledRoom(t, 3)
+	g, _ := room.StartGame(room.HostID)
+
+	if err := room.RemovePlayer("player-3"); err != nil {
+		t.Fatalf("RemovePlayer() error = %v", err)
+	}
+	if g.Players["player-3"].IsActive {
+		t.Error("A player leaving the room should be inactive in the game")
+	}
+}
@@ -0,0 +1,91 @@
+package lobby
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/leoferamos/coup-game/internal/game"
+)
+
+// DefaultStartCountdown is how long a room counts down before dealing once everyone is ready
+const DefaultStartCountdown = 5 * time.Second
+
+// SetReady marks a player as ready for the next game or not. Once every player is ready
+// and the room has enough of them, the game is created with the given options and counts
+// down in the game.Starting state; a player who is no longer ready cancels it.
+func (r *Room) SetReady(playerID string, ready bool, now time.Time, opts ...game.Option) error {
+	if r.Status == RoomPlaying {
+		return fmt.Errorf("room %s is already playing a game", r.Code)
+	}
+
+	if !r.hasPlayer(playerID) {
+		return fmt.Errorf("player with ID %s not found in room", playerID)
+	}
+
+	if r.Ready == nil {
+		r.Ready = make(map[string]bool)
+	}
+	r.Ready[playerID] = ready
+
+	if !ready {
+		r.CancelCountdown()
+		return nil
+	}
+
+	if r.Status == RoomWaiting && r.AllReady() && r.IsReadyToStart() {
+		g, err := r.newGame(opts...)
+		if err != nil {
+			return err
+		}
+
+		endsAt := now.Add(r.Countdown)
+		if err := g.BeginCountdown(endsAt); err != nil {
+			return err
+		}
+
+		r.Game = g
+		r.CountdownEndsAt = &endsAt
+		r.Status = RoomStarting
+	}
+	return nil
+}
+
+// AllReady reports whether every player in the room is ready
+func (r *Room) AllReady() bool {
+	if len(r.Players) == 0 {
+		return false
+	}
+
+	for _, player := range r.Players {
+		if !r.Ready[player.ID] {
+			return false
+		}
+	}
+	return true
+}
+
+// CancelCountdown stops the countdown to the game, returning the room to waiting and
+// dropping the game that was counting down
+func (r *Room) CancelCountdown() {
+	if r.Status != RoomStarting {
+		return
+	}
+
+	r.Game = nil
+	r.Status = RoomWaiting
+	r.CountdownEndsAt = nil
+}
+
+// Tick deals the game once the countdown is over, reporting whether it started. A game
+// that cannot start cancels the countdown.
+func (r *Room) Tick(now time.Time, opts ...game.Option) (bool, error) {
+	if r.Status != RoomStarting || now.Before(*r.CountdownEndsAt) {
+		return false, nil
+	}
+
+	if _, err := r.launch(opts...); err != nil {
+		r.CancelCountdown()
+		return false, err
+	}
+	return true, nil
+}
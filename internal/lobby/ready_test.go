@@ -0,0 +1,230 @@
+package lobby
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leoferamos/coup-game/internal/game"
+)
+
+// TDD: Test the countdown starts once every player is ready and deals when it ends
+func TestRoom_ReadyCheck(t *testing.T) {
+	room := newFilledRoom(t, 3)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	if err := room.SetReady("stranger", true, now); err == nil {
+		t.Error("SetReady() for a player outside the room should fail")
+	}
+
+	room.SetReady("player-1", true, now)
+	room.SetReady("player-2", true, now)
+	if room.Status != RoomWaiting || room.AllReady() {
+		t.Fatal("Room should wait while a player is not ready")
+	}
+
+	room.SetReady("player-3", true, now)
+	if room.Status != RoomStarting {
+		t.Fatalf("Room status = %v, want starting", room.Status)
+	}
+	if want := now.Add(DefaultStartCountdown); !room.CountdownEndsAt.Equal(want) {
+		t.Errorf("CountdownEndsAt = %v, want %v", room.CountdownEndsAt, want)
+	}
+	if room.Game == nil || room.Game.State != game.Starting || !room.Game.StartsAt.Equal(*room.CountdownEndsAt) {
+		t.Fatal("The game should count down in the Starting state")
+	}
+
+	if started, _ := room.Tick(now.Add(DefaultStartCountdown - time.Second)); started || room.Game.State != game.Starting {
+		t.Error("Tick() should not start the game before the countdown ends")
+	}
+
+	started, err := room.Tick(now.Add(DefaultStartCountdown))
+	if err != nil || !started {
+		t.Fatalf("Tick() = %v, %v, want true, nil", started, err)
+	}
+	if room.Status != RoomPlaying || room.Game.State != game.Playing {
+		t.Errorf("Room status = %v, want playing a dealt game", room.Status)
+	}
+	if len(room.Ready) != 0 || room.CountdownEndsAt != nil {
+		t.Error("Ready marks and the countdown should be cleared once the game starts")
+	}
+}
+
+// TDD: Test the countdown is cancelled by a player turning unready or leaving
+func TestRoom_CancelCountdown(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	readyAll := func(room *Room) {
+		for _, player := range room.Players {
+			room.SetReady(player.ID, true, now)
+		}
+	}
+
+	room := newFilledRoom(t, 3)
+	readyAll(room)
+	room.SetReady("player-2", false, now)
+	if room.Status != RoomWaiting || room.CountdownEndsAt != nil || room.Game != nil {
+		t.Errorf("Room status = %v, want waiting after a player turned unready", room.Status)
+	}
+
+	room = newFilledRoom(t, 4)
+	readyAll(room)
+	room.RemovePlayer("player-4")
+	if room.Status != RoomWaiting || room.Game != nil {
+		t.Errorf("Room status = %v, want waiting after a player left", room.Status)
+	}
+	if err := room.AddPlayer(Player{ID: "player-5"}); err != nil {
+		t.Errorf("AddPlayer() after the countdown was cancelled error = %v", err)
+	}
+
+	// Two ready players are not enough for a regular game
+	room = newFilledRoom(t, 2)
+	readyAll(room)
+	if room.Status != RoomWaiting {
+		t.Errorf("Room status = %v, want waiting without enough players", room.Status)
+	}
+}
+
+// TDD: Test the registry reports rooms whose game could not start
+func TestRegistry_Countdown_Failed(t *testing.T) {
+	registry, clock := newTestRegistry(DefaultRoomTTL)
+	room, _ := registry.CreateRoom()
+	registry.JoinRoom(room.Code, Player{ID: "p1", Name: "p1"})
+
+	// Force a countdown in a room without enough players
+	registry.UpdateRoom(room.Code, func(room *Room) error {
+		endsAt := clock.Now()
+		room.Status = RoomStarting
+		room.CountdownEndsAt = &endsAt
+		return nil
+	})
+
+	failed := registry.Tick()
+	if failed[room.Code] == nil {
+		t.Fatalf("Tick() = %v, want the failure of room %s", failed, room.Code)
+	}
+	if current, _ := registry.GetRoom(room.Code); current.Status != RoomWaiting {
+		t.Errorf("Room status = %v, want waiting after a failed start", current.Status)
+	}
+}
+
+// TDD: Test the registry runs countdowns and tells its listener about room changes
+func TestRegistry_Countdown(t *testing.T) {
+	registry, clock := newTestRegistry(DefaultRoomTTL)
+
+	var changes []*Room
+	registry.SetListener(func(room *Room) {
+		changes = append(changes, room)
+	})
+
+	room, _ := registry.CreateRoom()
+	for _, id := range []string{"p1", "p2", "p3"} {
+		registry.JoinRoom(room.Code, Player{ID: id, Name: id})
+	}
+	for _, id := range []string{"p1", "p2", "p3"} {
+		if err := registry.SetReady(room.Code, id, true); err != nil {
+			t.Fatalf("SetReady() error = %v", err)
+		}
+	}
+
+	last := changes[len(changes)-1]
+	if last.Status != RoomStarting || last.CountdownEndsAt == nil {
+		t.Fatalf("Listener saw status %v, want the countdown", last.Status)
+	}
+	if last.Game == nil || last.Game.State != game.Starting {
+		t.Fatal("Listener should see the game counting down in the Starting state")
+	}
+
+	clock.Advance(DefaultStartCountdown)
+	if failed := registry.Tick(); len(failed) != 0 {
+		t.Errorf("Tick() = %v, want no failures", failed)
+	}
+
+	last = changes[len(changes)-1]
+	if last.Status != RoomPlaying || last.Game == nil {
+		t.Errorf("Listener saw status %v, want playing", last.Status)
+	}
+	if current, _ := registry.GetRoom(room.Code); current.Game == nil {
+		t.Error("The registry should keep the started game")
+	}
+
+	// Once the game is over the room reopens with the same members
+	registry.UpdateRoom(room.Code, func(room *Room) error {
+		room.Game.Players["p2"].IsAlive = false
+		room.Game.Players["p3"].IsAlive = false
+		room.Game.CheckGameEnd()
+		return nil
+	})
+	registry.Tick()
+
+	last = changes[len(changes)-1]
+	if last.Status != RoomWaiting || last.Game != nil || len(last.Players) != 3 {
+		t.Errorf("Listener saw status %v with %d players, want waiting with 3", last.Status, len(last.Players))
+	}
+}
+
+// TDD: Test the registry runs the turn timers of games in progress
+func TestRegistry_Tick_GameTimers(t *testing.T) {
+	registry, clock := newTestRegistry(DefaultRoomTTL)
+	room, _ := registry.CreateRoom()
+	for _, id := range []string{"p1", "p2", "p3"} {
+		registry.JoinRoom(room.Code, Player{ID: id, Name: id})
+	}
+
+	rules := game.DefaultRuleSet()
+	rules.Timers = game.DefaultTimers()
+	registry.UpdateRoom(room.Code, func(room *Room) error {
+		_, err := room.StartGame("p1", game.WithClock(clock), game.WithRuleSet(rules))
+		return err
+	})
+
+	var changes []*Room
+	registry.SetListener(func(room *Room) {
+		changes = append(changes, room)
+	})
+
+	registry.Tick()
+	if len(changes) != 0 {
+		t.Errorf("Tick() before the deadline told the listener about %d changes, want none", len(changes))
+	}
+
+	clock.Advance(game.DefaultTimers().Action)
+	if failed := registry.Tick(); len(failed) != 0 {
+		t.Fatalf("Tick() = %v, want no failures", failed)
+	}
+
+	current, _ := registry.GetRoom(room.Code)
+	if current.Game.CurrentPlayer != 1 || current.Game.Players["p1"].Coins != game.StartingCoins+1 {
+		t.Errorf("CurrentPlayer = %d, want p1 to have taken Income on timeout", current.Game.CurrentPlayer)
+	}
+	if len(changes) != 1 {
+		t.Errorf("Listener saw %d changes, want the timed-out move", len(changes))
+	}
+}
+
+// TDD: Test the registry reopens a room whose game a timeout finishes
+func TestRegistry_Tick_GameTimers_Finish(t *testing.T) {
+	registry, clock := newTestRegistry(DefaultRoomTTL)
+	room, _ := registry.CreateRoom()
+	for _, id := range []string{"p1", "p2", "p3"} {
+		registry.JoinRoom(room.Code, Player{ID: id, Name: id})
+	}
+
+	rules := game.DefaultRuleSet()
+	rules.TimeBank = game.TimeBank{Base: time.Minute, Eliminate: true}
+	registry.UpdateRoom(room.Code, func(room *Room) error {
+		_, err := room.StartGame("p1", game.WithClock(clock), game.WithRuleSet(rules))
+		return err
+	})
+
+	// Every player lets their bank run out in turn and is eliminated
+	for i := 0; i < 3; i++ {
+		clock.Advance(time.Minute)
+		if failed := registry.Tick(); len(failed) != 0 {
+			t.Fatalf("Tick() = %v, want no failures", failed)
+		}
+	}
+
+	current, _ := registry.GetRoom(room.Code)
+	if current.Status != RoomWaiting || current.Game != nil || len(current.Players) != 3 {
+		t.Errorf("Room status = %v, want waiting with its members once the game is over", current.Status)
+	}
+}
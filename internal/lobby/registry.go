@@ -0,0 +1,309 @@
+package lobby
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/leoferamos/coup-game/internal/game"
+)
+
+const (
+	// DefaultRoomTTL is how long a room may sit idle before the registry closes it
+	DefaultRoomTTL = 30 * time.Minute
+	// maxCodeAttempts is how many random codes are tried before searching for a free one
+	maxCodeAttempts = 100
+)
+
+// Registry owns every open room, keeping their codes unique. It is safe for concurrent
+// use; rooms and their games must only be changed through it. Rooms handed out are
+// snapshots holding a copy of the game, so changing them never affects the registry.
+type Registry struct {
+	rooms map[string]*Room // Open rooms indexed by code
+	rng   *game.Random
+	clock game.Clock
+	ttl   time.Duration // Idle time after which a room is closed, zero to keep rooms open
+	mu    sync.Mutex
+
+	listener func(room *Room) // Told about every room change, e.g. to broadcast it
+}
+
+// NewRegistry creates a registry that closes rooms left idle for ttl
+func NewRegistry(ttl time.Duration) *Registry {
+	return NewRegistryFrom(game.NewRandom(time.Now().UnixNano()), game.SystemClock{}, ttl)
+}
+
+// NewRegistryFrom creates a registry whose codes and times come from the given sources,
+// so registries can be reproduced in simulations and tests
+func NewRegistryFrom(rng *game.Random, clock game.Clock, ttl time.Duration) *Registry {
+	return &Registry{
+		rooms: make(map[string]*Room),
+		rng:   rng,
+		clock: clock,
+		ttl:   ttl,
+	}
+}
+
+// CreateRoom opens a new room with a code no other open room uses
+func (reg *Registry) CreateRoom() (*Room, error) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	if len(reg.rooms) >= RoomCodeCount {
+		return nil, fmt.Errorf("all %d room codes are in use", RoomCodeCount)
+	}
+
+	room := NewRoom(reg.rng, reg.clock)
+	for attempt := 0; reg.rooms[room.Code] != nil; attempt++ {
+		if attempt < maxCodeAttempts {
+			room.Code = generateRoomCode(reg.rng)
+			continue
+		}
+		// The registry is nearly full: take the next free code
+		room.Code = nextRoomCode(room.Code)
+	}
+
+	reg.rooms[room.Code] = room
+	return room.snapshot(), nil
+}
+
+// nextRoomCode returns the code following the given one, wrapping after 9999
+func nextRoomCode(code string) string {
+	var n int
+	fmt.Sscanf(code, "%d", &n)
+	return fmt.Sprintf("%04d", (n+1)%RoomCodeCount)
+}
+
+// GetRoom returns a snapshot of the room with the given code
+func (reg *Registry) GetRoom(code string) (*Room, bool) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	room, exists := reg.rooms[code]
+	if !exists {
+		return nil, false
+	}
+	return room.snapshot(), true
+}
+
+// SetListener registers a function told about every room change made through the
+// registry. It receives a snapshot and is called without the registry lock held.
+func (reg *Registry) SetListener(listener func(room *Room)) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.listener = listener
+}
+
+// notify tells the listener about changed rooms
+func (reg *Registry) notify(listener func(room *Room), rooms []*Room) {
+	if listener == nil {
+		return
+	}
+	for _, room := range rooms {
+		listener(room)
+	}
+}
+
+// UpdateRoom changes a room while holding the registry lock and marks it as active.
+// fn works on a snapshot, game included, which replaces the room only if fn returns
+// no error.
+func (reg *Registry) UpdateRoom(code string, fn func(room *Room) error) error {
+	reg.mu.Lock()
+
+	room, exists := reg.rooms[code]
+	if !exists {
+		reg.mu.Unlock()
+		return fmt.Errorf("room %s not found", code)
+	}
+
+	updated := room.snapshot()
+	if err := fn(updated); err != nil {
+		reg.mu.Unlock()
+		return err
+	}
+
+	updated.LastActive = reg.clock.Now()
+	reg.rooms[code] = updated
+	listener := reg.listener
+	reg.mu.Unlock()
+
+	reg.notify(listener, []*Room{updated.snapshot()})
+	return nil
+}
+
+// ApplyMove makes a move in the game of the room with the given code
+func (reg *Registry) ApplyMove(code string, move game.Move) error {
+	return reg.UpdateRoom(code, func(room *Room) error {
+		if !room.InGame() {
+			return fmt.Errorf("room %s is not playing a game", room.Code)
+		}
+		return room.Game.ApplyMove(move)
+	})
+}
+
+// JoinRoom adds a player to the room with the given code
+func (reg *Registry) JoinRoom(code string, player Player) error {
+	return reg.UpdateRoom(code, func(room *Room) error {
+		return room.AddPlayer(player)
+	})
+}
+
+// SetReady marks a player as ready or not, starting the countdown once everyone is ready
+func (reg *Registry) SetReady(code, playerID string, ready bool) error {
+	now := reg.clock.Now()
+	return reg.UpdateRoom(code, func(room *Room) error {
+		return room.SetReady(playerID, ready, now, game.WithClock(reg.clock))
+	})
+}
+
+// JoinRoomWith adds a player to the room with the given code using the credentials of a
+// private room
+func (reg *Registry) JoinRoomWith(code string, player Player, credentials Credentials) error {
+	return reg.UpdateRoom(code, func(room *Room) error {
+		return room.Join(player, credentials)
+	})
+}
+
+// LeaveRoom removes a player from the room with the given code
+func (reg *Registry) LeaveRoom(code, playerID string) error {
+	return reg.UpdateRoom(code, func(room *Room) error {
+		return room.RemovePlayer(playerID)
+	})
+}
+
+// CloseRoom removes the room with the given code from the registry
+func (reg *Registry) CloseRoom(code string) error {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	if _, exists := reg.rooms[code]; !exists {
+		return fmt.Errorf("room %s not found", code)
+	}
+
+	delete(reg.rooms, code)
+	return nil
+}
+
+// RoomCount returns the number of open rooms
+func (reg *Registry) RoomCount() int {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	return len(reg.rooms)
+}
+
+// ExpireRooms closes every room idle for longer than the TTL, empty or not, and returns
+// their codes. Game moves made through ApplyMove mark a room as active, so only rooms
+// whose game has been abandoned are closed mid-game.
+func (reg *Registry) ExpireRooms() []string {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	expired := make([]string, 0)
+	if reg.ttl <= 0 {
+		return expired
+	}
+
+	now := reg.clock.Now()
+	for code, room := range reg.rooms {
+		if now.Sub(room.LastActive) >= reg.ttl {
+			delete(reg.rooms, code)
+			expired = append(expired, code)
+		}
+	}
+	return expired
+}
+
+// Tick expires idle rooms, starts the games of rooms whose countdown is over, runs the
+// turn timers and time banks of games in progress, and reopens rooms whose game has
+// finished. Rooms whose game cannot start go back to waiting; a game whose timers fail is
+// left as it was. The errors are returned indexed by room code. Moves made for players
+// who ran out of time do not mark the room as active.
+func (reg *Registry) Tick() map[string]error {
+	reg.ExpireRooms()
+
+	reg.mu.Lock()
+	now := reg.clock.Now()
+	changed := make([]*Room, 0)
+	failed := make(map[string]error)
+	for code, room := range reg.rooms {
+		var updated *Room
+		switch {
+		case room.InGame():
+			updated = room.snapshot()
+			timedOut, err := updated.Game.Tick()
+			if err != nil {
+				failed[code] = err
+				continue
+			}
+			reg.rooms[code] = updated
+			if updated.ResetIfFinished() || timedOut {
+				changed = append(changed, updated.snapshot())
+			}
+			continue
+		case room.Status == RoomPlaying:
+			updated = room.snapshot()
+			updated.ResetIfFinished()
+		case room.Status == RoomStarting && !now.Before(*room.CountdownEndsAt):
+			updated = room.snapshot()
+			if _, err := updated.Tick(now, game.WithClock(reg.clock)); err != nil {
+				failed[code] = err
+			}
+		default:
+			continue
+		}
+
+		updated.LastActive = now
+		reg.rooms[code] = updated
+		changed = append(changed, updated.snapshot())
+	}
+	listener := reg.listener
+	reg.mu.Unlock()
+
+	reg.notify(listener, changed)
+	return failed
+}
+
+// StartTicker runs Tick at the given interval until the returned stop function is called
+func (reg *Registry) StartTicker(interval time.Duration) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				for code, err := range reg.Tick() {
+					log.Printf("Room %s failed to tick: %v", code, err)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() { once.Do(func() { close(done) }) }
+}
+
+// snapshot returns a copy of the room that shares no player list, ready marks, spent
+// invites or game with it
+func (r *Room) snapshot() *Room {
+	clone := *r
+	if r.Game != nil {
+		clone.Game = r.Game.Clone()
+	}
+	clone.Players = append(make([]Player, 0, len(r.Players)), r.Players...)
+	clone.Ready = make(map[string]bool, len(r.Ready))
+	for id, ready := range r.Ready {
+		clone.Ready[id] = ready
+	}
+	if r.usedInvites != nil {
+		clone.usedInvites = make(map[string]bool, len(r.usedInvites))
+		for nonce := range r.usedInvites {
+			clone.usedInvites[nonce] = true
+		}
+	}
+	return &clone
+}
@@ -0,0 +1,234 @@
+package lobby
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/leoferamos/coup-game/internal/game"
+)
+
+// newTestRegistry creates a registry on a fake clock
+func newTestRegistry(ttl time.Duration) (*Registry, *game.FakeClock) {
+	clock := game.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	return NewRegistryFrom(game.NewRandom(42), clock, ttl), clock
+}
+
+// TDD: Test registry rooms get unique codes and can be looked up
+func TestRegistry_CreateRoom(t *testing.T) {
+	registry, _ := newTestRegistry(DefaultRoomTTL)
+
+	codes := make(map[string]bool)
+	for i := 0; i < 500; i++ {
+		room, err := registry.CreateRoom()
+		if err != nil {
+			t.Fatalf("CreateRoom() error = %v", err)
+		}
+		if codes[room.Code] {
+			t.Fatalf("CreateRoom() reused code %s", room.Code)
+		}
+		codes[room.Code] = true
+	}
+
+	if count := registry.RoomCount(); count != 500 {
+		t.Errorf("RoomCount() = %d, want 500", count)
+	}
+
+	for code := range codes {
+		if room, ok := registry.GetRoom(code); !ok || room.Code != code {
+			t.Errorf("GetRoom(%s) = %v, %v", code, room, ok)
+		}
+		break
+	}
+
+	if _, ok := registry.GetRoom("abcd"); ok {
+		t.Error("GetRoom() should not find an unknown code")
+	}
+}
+
+// TDD: Test every code can be handed out before the registry is full
+func TestRegistry_Full(t *testing.T) {
+	registry, _ := newTestRegistry(DefaultRoomTTL)
+
+	for i := 0; i < RoomCodeCount; i++ {
+		if _, err := registry.CreateRoom(); err != nil {
+			t.Fatalf("CreateRoom() #%d error = %v", i, err)
+		}
+	}
+
+	if _, err := registry.CreateRoom(); err == nil {
+		t.Error("CreateRoom() should fail when every code is in use")
+	}
+}
+
+// TDD: Test joining and leaving rooms through the registry
+func TestRegistry_JoinLeave(t *testing.T) {
+	registry, _ := newTestRegistry(DefaultRoomTTL)
+	room, _ := registry.CreateRoom()
+
+	if err := registry.JoinRoom(room.Code, Player{ID: "p1", Name: "Alice"}); err != nil {
+		t.Fatalf("JoinRoom() error = %v", err)
+	}
+	if err := registry.JoinRoom(room.Code, Player{ID: "p1", Name: "Alice"}); err == nil {
+		t.Error("JoinRoom() should reject a duplicate player")
+	}
+	if err := registry.JoinRoom("9999x", Player{ID: "p2"}); err == nil {
+		t.Error("JoinRoom() should fail for an unknown room")
+	}
+
+	// Snapshots do not change the registry
+	snapshot, _ := registry.GetRoom(room.Code)
+	snapshot.Players = nil
+
+	current, _ := registry.GetRoom(room.Code)
+	if len(current.Players) != 1 {
+		t.Fatalf("Room has %d players, want 1", len(current.Players))
+	}
+
+	if err := registry.LeaveRoom(room.Code, "p1"); err != nil {
+		t.Fatalf("LeaveRoom() error = %v", err)
+	}
+	if err := registry.CloseRoom(room.Code); err != nil {
+		t.Fatalf("CloseRoom() error = %v", err)
+	}
+	if _, ok := registry.GetRoom(room.Code); ok {
+		t.Error("GetRoom() should not find a closed room")
+	}
+}
+
+// TDD: Test idle rooms expire after the TTL while active ones stay open
+func TestRegistry_ExpireRooms(t *testing.T) {
+	registry, clock := newTestRegistry(10 * time.Minute)
+	idle, _ := registry.CreateRoom()
+	active, _ := registry.CreateRoom()
+
+	clock.Advance(6 * time.Minute)
+	registry.JoinRoom(active.Code, Player{ID: "p1", Name: "Alice"})
+
+	clock.Advance(5 * time.Minute)
+	expired := registry.ExpireRooms()
+	if len(expired) != 1 || expired[0] != idle.Code {
+		t.Errorf("ExpireRooms() = %v, want [%s]", expired, idle.Code)
+	}
+
+	if _, ok := registry.GetRoom(active.Code); !ok {
+		t.Error("an active room should not expire")
+	}
+
+	forever, foreverClock := newTestRegistry(0)
+	forever.CreateRoom()
+	foreverClock.Advance(24 * time.Hour)
+	if expired := forever.ExpireRooms(); len(expired) != 0 {
+		t.Errorf("ExpireRooms() without a TTL = %v, want none", expired)
+	}
+}
+
+// TDD: Test rooms playing a game stay open while moves are made and expire once abandoned
+func TestRegistry_ExpireRooms_InGame(t *testing.T) {
+	registry, clock := newTestRegistry(10 * time.Minute)
+	room, _ := registry.CreateRoom()
+	for _, id := range []string{"p1", "p2", "p3"} {
+		registry.JoinRoom(room.Code, Player{ID: id, Name: id})
+	}
+	registry.UpdateRoom(room.Code, func(room *Room) error {
+		_, err := room.StartGame("p1", game.WithClock(clock))
+		return err
+	})
+
+	players := []string{"p1", "p2", "p3"}
+	for i := 0; i < 11; i++ {
+		clock.Advance(time.Minute)
+		move := game.Move{Kind: game.MoveAction, PlayerID: players[i%3], Action: game.Income}
+		if err := registry.ApplyMove(room.Code, move); err != nil {
+			t.Fatalf("ApplyMove() error = %v", err)
+		}
+	}
+	if expired := registry.ExpireRooms(); len(expired) != 0 {
+		t.Errorf("ExpireRooms() = %v, want a room in play kept open", expired)
+	}
+
+	for _, id := range players {
+		registry.LeaveRoom(room.Code, id)
+	}
+	clock.Advance(10 * time.Minute)
+	if expired := registry.ExpireRooms(); len(expired) != 1 {
+		t.Errorf("ExpireRooms() = %v, want the abandoned room closed mid-game", expired)
+	}
+}
+
+// TDD: Test game moves go through the registry and snapshots hold their own copy
+func TestRegistry_ApplyMove(t *testing.T) {
+	registry, clock := newTestRegistry(DefaultRoomTTL)
+	room, _ := registry.CreateRoom()
+	for _, id := range []string{"p1", "p2", "p3"} {
+		registry.JoinRoom(room.Code, Player{ID: id, Name: id})
+	}
+
+	income := game.Move{Kind: game.MoveAction, PlayerID: "p1", Action: game.Income}
+	if err := registry.ApplyMove(room.Code, income); err == nil {
+		t.Error("ApplyMove() should fail before the game starts")
+	}
+
+	registry.UpdateRoom(room.Code, func(room *Room) error {
+		_, err := room.StartGame("p1", game.WithClock(clock))
+		return err
+	})
+	before, _ := registry.GetRoom(room.Code)
+
+	clock.Advance(time.Minute)
+	if err := registry.ApplyMove(room.Code, income); err != nil {
+		t.Fatalf("ApplyMove() error = %v", err)
+	}
+	if err := registry.ApplyMove(room.Code, income); err == nil {
+		t.Error("ApplyMove() out of turn should fail")
+	}
+
+	after, _ := registry.GetRoom(room.Code)
+	if after.Game.CurrentPlayer != 1 || before.Game.CurrentPlayer != 0 {
+		t.Errorf("CurrentPlayer = %d after and %d before, want 1 and 0", after.Game.CurrentPlayer, before.Game.CurrentPlayer)
+	}
+	if !after.LastActive.Equal(clock.Now()) {
+		t.Errorf("LastActive = %v, want the time of the move", after.LastActive)
+	}
+
+	// Changing a snapshot's game leaves the registry's game alone
+	after.Game.Players["p2"].Coins = 99
+	if current, _ := registry.GetRoom(room.Code); current.Game.Players["p2"].Coins == 99 {
+		t.Error("GetRoom() should hand out a copy of the game")
+	}
+}
+
+// TDD: Test the registry is safe for concurrent use
+func TestRegistry_Concurrent(t *testing.T) {
+	registry := NewRegistry(DefaultRoomTTL)
+	stop := registry.StartTicker(time.Millisecond)
+	defer stop()
+
+	var wg sync.WaitGroup
+	codes := make(chan string, 100)
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			room, err := registry.CreateRoom()
+			if err != nil {
+				t.Errorf("CreateRoom() error = %v", err)
+				return
+			}
+			registry.JoinRoom(room.Code, Player{ID: fmt.Sprintf("p%d", i)})
+			registry.GetRoom(room.Code)
+			codes <- room.Code
+		}(i)
+	}
+	wg.Wait()
+	close(codes)
+
+	seen := make(map[string]bool)
+	for code := range codes {
+		if seen[code] {
+			t.Errorf("code %s handed out twice", code)
+		}
+		seen[code] = true
+	}
+}